@@ -28,12 +28,60 @@ package packages
 
 import (
 	col "github.com/craterdog/go-collection-framework/v3"
+	fss "io/fs"
 )
 
 // TYPES
 
 // Specializations
 
+/*
+ChangeType is a specialized type representing the kind of change that was made
+to a model.
+*/
+type ChangeType uint8
+
+const (
+	ErrorChange ChangeType = iota
+	AddedChange
+	ModifiedChange
+	RemovedChange
+)
+
+/*
+FormatType is a specialized type representing the format of a generated
+document.
+*/
+type FormatType uint8
+
+const (
+	ErrorFormat FormatType = iota
+	HTMLFormat
+	MarkdownFormat
+	DOTFormat
+	MermaidFormat
+	PlantUMLFormat
+)
+
+/*
+OptionType is a specialized type representing an optional artifact that a
+generator can produce in addition to the generated class files, an optional
+feature of the generated class files themselves, or a step of the generation
+that may be skipped.
+*/
+type OptionType uint8
+
+const (
+	ErrorOption OptionType = iota
+	MocksOption
+	SynchronizedOption
+	NilChecksOption
+	ValueMethodsOption
+	SerializationOption
+	InstrumentedOption
+	UncheckedOption
+)
+
 /*
 PrefixType is a specialized type representing a prefix type.
 */
@@ -47,6 +95,21 @@ const (
 	MapPrefix
 )
 
+/*
+RuleType is a specialized type representing a configurable lint rule that can
+be checked by a validator.
+*/
+type RuleType uint8
+
+const (
+	ErrorRule RuleType = iota
+	CommentRule
+	ConstructorRule
+	EnumerationRule
+	ParameterRule
+	UsageRule
+)
+
 /*
 TokenType is a specialized type representing any token type recognized by a
 scanner.
@@ -55,6 +118,7 @@ type TokenType uint8
 
 const (
 	ErrorToken TokenType = iota
+	AnnotationToken
 	CommentToken
 	DelimiterToken
 	EOFToken
@@ -67,6 +131,61 @@ const (
 
 // INTERFACES
 
+// Aspects
+
+/*
+Visitor defines the set of method signatures that must be supported by any
+visitor of the nodes in a model.  Each node is entered before any of its child
+nodes are visited and left after all of them have been visited.
+*/
+type Visitor interface {
+	// Methods
+	EnterAbstraction(abstraction AbstractionLike)
+	EnterAspect(aspect AspectLike)
+	EnterAttribute(attribute AttributeLike)
+	EnterClass(class ClassLike)
+	EnterConstant(constant ConstantLike)
+	EnterConstructor(constructor ConstructorLike)
+	EnterDeclaration(declaration DeclarationLike)
+	EnterEnumeration(enumeration EnumerationLike)
+	EnterFunction(function FunctionLike)
+	EnterFunctional(functional FunctionalLike)
+	EnterHeader(header HeaderLike)
+	EnterImports(imports ImportsLike)
+	EnterInstance(instance InstanceLike)
+	EnterInterfaces(interfaces InterfacesLike)
+	EnterMethod(method MethodLike)
+	EnterModel(model ModelLike)
+	EnterModule(module ModuleLike)
+	EnterNotice(notice NoticeLike)
+	EnterParameter(parameter ParameterLike)
+	EnterResult(result ResultLike)
+	EnterSpecialization(specialization SpecializationLike)
+	EnterTypes(types TypesLike)
+	LeaveAbstraction(abstraction AbstractionLike)
+	LeaveAspect(aspect AspectLike)
+	LeaveAttribute(attribute AttributeLike)
+	LeaveClass(class ClassLike)
+	LeaveConstant(constant ConstantLike)
+	LeaveConstructor(constructor ConstructorLike)
+	LeaveDeclaration(declaration DeclarationLike)
+	LeaveEnumeration(enumeration EnumerationLike)
+	LeaveFunction(function FunctionLike)
+	LeaveFunctional(functional FunctionalLike)
+	LeaveHeader(header HeaderLike)
+	LeaveImports(imports ImportsLike)
+	LeaveInstance(instance InstanceLike)
+	LeaveInterfaces(interfaces InterfacesLike)
+	LeaveMethod(method MethodLike)
+	LeaveModel(model ModelLike)
+	LeaveModule(module ModuleLike)
+	LeaveNotice(notice NoticeLike)
+	LeaveParameter(parameter ParameterLike)
+	LeaveResult(result ResultLike)
+	LeaveSpecialization(specialization SpecializationLike)
+	LeaveTypes(types TypesLike)
+}
+
 // Classes
 
 /*
@@ -75,6 +194,11 @@ functions that must be supported by all abstraction-class-like classes.
 */
 type AbstractionClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates an abstraction whose prefix and arguments may
+		be nil.
+		optional: prefix, arguments
+	*/
 	MakeWithAttributes(
 		prefix PrefixLike,
 		identifier string,
@@ -106,6 +230,10 @@ functions that must be supported by all aspect-class-like classes.
 */
 type AspectClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates an aspect whose methods may be nil.
+		optional: methods
+	*/
 	MakeWithAttributes(declaration DeclarationLike, methods MethodsLike) AspectLike
 }
 
@@ -124,10 +252,17 @@ functions that must be supported by all attribute-class-like classes.
 */
 type AttributeClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates an attribute whose parameter and abstraction
+		may be nil.
+		optional: parameter, abstraction
+	*/
 	MakeWithAttributes(
+		comment string,
 		identifier string,
 		parameter ParameterLike,
 		abstraction AbstractionLike,
+		default_ string,
 	) AttributeLike
 }
 
@@ -140,12 +275,43 @@ type AttributesClassLike interface {
 	MakeWithAttributes(sequence col.Sequential[AttributeLike]) AttributesLike
 }
 
+/*
+ChangeClassLike defines the set of class constants, constructors and functions
+that must be supported by all change-class-like classes.
+*/
+type ChangeClassLike interface {
+	// Constructors
+	MakeWithAttributes(
+		type_ ChangeType,
+		path string,
+		description string,
+		breaking bool,
+	) ChangeLike
+
+	// Functions
+	AsString(type_ ChangeType) string
+}
+
+/*
+ChangesClassLike defines the set of class constants, constructors and functions
+that must be supported by all changes-class-like classes.
+*/
+type ChangesClassLike interface {
+	// Constructors
+	MakeWithAttributes(sequence col.Sequential[ChangeLike]) ChangesLike
+}
+
 /*
 ClassClassLike defines the set of class constants, constructors and
 functions that must be supported by all class-class-like classes.
 */
 type ClassClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a class whose constants, constructors and
+		functions may be nil.
+		optional: constants, constructors, functions
+	*/
 	MakeWithAttributes(
 		declaration DeclarationLike,
 		constants ConstantsLike,
@@ -163,13 +329,44 @@ type ClassesClassLike interface {
 	MakeWithAttributes(sequence col.Sequential[ClassLike]) ClassesLike
 }
 
+/*
+CodecClassLike defines the set of class constants, constructors and functions
+that must be supported by all codec-class-like classes.
+*/
+type CodecClassLike interface {
+	// Constants
+	/*
+		SchemaVersion returns the version of the JSON schema that is used to
+		export models.  Models exported using an older version of the schema
+		can still be imported.
+	*/
+	SchemaVersion() string // = "1.0"
+
+	// Constructors
+	Make() CodecLike
+}
+
+/*
+ComparatorClassLike defines the set of class constants, constructors and
+functions that must be supported by all comparator-class-like classes.
+*/
+type ComparatorClassLike interface {
+	// Constructors
+	Make() ComparatorLike
+}
+
 /*
 ConstantClassLike defines the set of class constants, constructors and
 functions that must be supported by all constant-class-like classes.
 */
 type ConstantClassLike interface {
 	// Constructors
-	MakeWithAttributes(identifier string, abstraction AbstractionLike) ConstantLike
+	MakeWithAttributes(
+		comment string,
+		identifier string,
+		abstraction AbstractionLike,
+		value string,
+	) ConstantLike
 }
 
 /*
@@ -187,7 +384,12 @@ functions that must be supported by all constructor-class-like classes.
 */
 type ConstructorClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a constructor whose parameters may be nil.
+		optional: parameters
+	*/
 	MakeWithAttributes(
+		comment string,
 		identifier string,
 		parameters ParametersLike,
 		abstraction AbstractionLike,
@@ -209,6 +411,10 @@ functions that must be supported by all declaration-class-like classes.
 */
 type DeclarationClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a declaration whose parameters may be nil.
+		optional: parameters
+	*/
 	MakeWithAttributes(
 		comment string,
 		identifier string,
@@ -216,6 +422,41 @@ type DeclarationClassLike interface {
 	) DeclarationLike
 }
 
+/*
+DiagnosticClassLike defines the set of class constants, constructors and
+functions that must be supported by all diagnostic-class-like classes.
+*/
+type DiagnosticClassLike interface {
+	// Constructors
+	MakeWithAttributes(
+		rule RuleType,
+		path string,
+		description string,
+	) DiagnosticLike
+
+	// Functions
+	AsRule(name string) RuleType
+	AsString(rule RuleType) string
+}
+
+/*
+DiagrammerClassLike defines the set of class constants, constructors and
+functions that must be supported by all diagrammer-class-like classes.
+*/
+type DiagrammerClassLike interface {
+	// Constructors
+	MakeWithFormat(format FormatType) DiagrammerLike
+}
+
+/*
+DocumenterClassLike defines the set of class constants, constructors and
+functions that must be supported by all documenter-class-like classes.
+*/
+type DocumenterClassLike interface {
+	// Constructors
+	MakeWithFormat(format FormatType) DocumenterLike
+}
+
 /*
 EnumerationClassLike defines the set of class constants, constructors and
 functions that must be supported by all enumeration-class-like classes.
@@ -240,7 +481,12 @@ functions that must be supported by all function-class-like classes.
 */
 type FunctionClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a function whose parameters may be nil.
+		optional: parameters
+	*/
 	MakeWithAttributes(
+		comment string,
 		identifier string,
 		parameters ParametersLike,
 		result ResultLike,
@@ -253,6 +499,10 @@ functions that must be supported by all functional-class-like classes.
 */
 type FunctionalClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a functional whose parameters may be nil.
+		optional: parameters
+	*/
 	MakeWithAttributes(
 		declaration DeclarationLike,
 		parameters ParametersLike,
@@ -285,6 +535,48 @@ functions that must be supported by all generator-class-like classes.
 type GeneratorClassLike interface {
 	// Constructors
 	Make() GeneratorLike
+	MakeWithOptions(options ...OptionType) GeneratorLike
+	/*
+		MakeWithTemplates creates a generator that renders each class file using
+		the "class.tmpl" text template from the specified template set instead
+		of the built-in templates.  The template may invoke any other template
+		in the set by name, and the "imports" function to mark where the import
+		declarations belong.  It is executed with a class value that has the
+		following fields, the textual ones contain Go source text:
+		  Notice, Package, Name, Target, Parameters, Arguments, Comment
+		    - the copyright notice, package name, class name, private class
+		      name, generic parameters and arguments, and class comment
+		  Constants - a list of constants with a Name, Field, Type, Value and
+		    Comment
+		  Constructors, Functions - lists of methods
+		  Instance - the paired instance, or nil for a standalone class
+		Each method has a Name, Parameters, Arguments (the parameter names),
+		Result, IsNamed (whether the results are named), Comment, and for a
+		constructor the Assignments (a list of fields with a Name and Value)
+		and the Checks (the names of the parameters to check for nil).  The
+		instance has the following fields:
+		  Name, Comment - the instance interface name and comment
+		  Fields - a list of private attributes with a Name and Type
+		  Attributes - a list of attribute methods with a Name, Field,
+		    Parameter, Argument, Result, Default and Comment
+		  Abstractions - a list of abstractions with a Name and the Methods
+		    that they define
+		  Methods - a list of public methods
+		  Value - the value methods, or nil
+		  Serialization - the serialization methods, or nil
+		The value methods consist of the Methods to be generated (some of
+		"Equal", "Copy" and "String") and a list of Fields with a Name, Type,
+		Kind ("value", "slice", "map", "sequence" or "functional"), the
+		ElementType of a collection, IsComparable (whether its values may be
+		compared using "!="), and the module Alias of a copyable sequence.  The
+		serialization methods consist of the Methods to be generated (some of
+		"MarshalJSON", "UnmarshalJSON", "MarshalCDCN" and "UnmarshalCDCN") and
+		the Parameters of the MakeWithAttributes constructor, each with a Name,
+		Field, Type and IsVariadic.  The CDCN methods may use the generated
+		encodeCDCN() and decodeCDCN() functions.  The checks, value methods and serialization methods are
+		only provided when they are enabled by the options.
+	*/
+	MakeWithTemplates(templates fss.FS, options ...OptionType) GeneratorLike
 }
 
 /*
@@ -302,6 +594,10 @@ that must be supported by all imports-class-like classes.
 */
 type ImportsClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a set of imports whose modules may be nil.
+		optional: modules
+	*/
 	MakeWithAttributes(modules ModulesLike) ImportsLike
 }
 
@@ -311,6 +607,11 @@ that must be supported by all instance-class-like classes.
 */
 type InstanceClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates an instance whose attributes, abstractions
+		and methods may be nil.
+		optional: attributes, abstractions, methods
+	*/
 	MakeWithAttributes(
 		declaration DeclarationLike,
 		attributes AttributesLike,
@@ -334,6 +635,11 @@ functions that must be supported by all interfaces-class-like classes.
 */
 type InterfacesClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a set of interfaces whose aspects, classes
+		and instances may be nil.
+		optional: aspects, classes, instances
+	*/
 	MakeWithAttributes(
 		aspects AspectsLike,
 		classes ClassesLike,
@@ -347,7 +653,13 @@ that must be supported by all method-class-like classes.
 */
 type MethodClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a method whose parameters and result may be
+		nil.
+		optional: parameters, result
+	*/
 	MakeWithAttributes(
+		comment string,
 		identifier string,
 		parameters ParametersLike,
 		result ResultLike,
@@ -369,6 +681,11 @@ that must be supported by all package-class-like classes.
 */
 type ModelClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a model whose imports, types and interfaces
+		may be nil.
+		optional: imports, types, interfaces
+	*/
 	MakeWithAttributes(
 		notice NoticeLike,
 		header HeaderLike,
@@ -411,7 +728,11 @@ functions that must be supported by all parameter-class-like classes.
 */
 type ParameterClassLike interface {
 	// Constructors
-	MakeWithAttributes(identifier string, abstraction AbstractionLike) ParameterLike
+	MakeWithAttributes(
+		identifier string,
+		variadic bool,
+		abstraction AbstractionLike,
+	) ParameterLike
 }
 
 /*
@@ -469,6 +790,11 @@ functions that must be supported by all specialization-class-like classes.
 */
 type SpecializationClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a specialization whose enumeration may be
+		nil.
+		optional: enumeration
+	*/
 	MakeWithAttributes(
 		declaration DeclarationLike,
 		abstraction AbstractionLike,
@@ -485,6 +811,20 @@ type SpecializationsClassLike interface {
 	MakeWithAttributes(sequence col.Sequential[SpecializationLike]) SpecializationsLike
 }
 
+/*
+SubstitutorClassLike defines the set of class constants, constructors and
+functions that must be supported by all substitutor-class-like classes.
+*/
+type SubstitutorClassLike interface {
+	// Constructors
+	/*
+		MakeWithTypes creates a substitutor whose generic and concrete types may
+		be nil.
+		optional: genericTypes, concreteTypes
+	*/
+	MakeWithTypes(genericTypes ParametersLike, concreteTypes ArgumentsLike) SubstitutorLike
+}
+
 /*
 TokenClassLike defines the set of class constants, constructors and functions
 that must be supported by all token-class-like classes.
@@ -508,6 +848,11 @@ that must be supported by all types-class-like classes.
 */
 type TypesClassLike interface {
 	// Constructors
+	/*
+		MakeWithAttributes creates a set of types whose specializations and
+		functionals may be nil.
+		optional: specializations, functionals
+	*/
 	MakeWithAttributes(specializations SpecializationsLike, functionals FunctionalsLike) TypesLike
 }
 
@@ -518,6 +863,15 @@ functions that must be supported by all validator-class-like classes.
 type ValidatorClassLike interface {
 	// Constructors
 	Make() ValidatorLike
+	MakeWithRules(rules ...RuleType) ValidatorLike
+
+	// Functions
+	/*
+		ReadRules returns the lint rules that are enabled in the specified
+		configuration file.  Each line of the file names a single rule, blank
+		lines and lines beginning with "#" are ignored.
+	*/
+	ReadRules(filename string) []RuleType
 }
 
 /*
@@ -529,6 +883,15 @@ type ValuesClassLike interface {
 	MakeWithAttributes(parameter ParameterLike, sequence col.Sequential[string]) ValuesLike
 }
 
+/*
+WalkerClassLike defines the set of class constants, constructors and functions
+that must be supported by all walker-class-like classes.
+*/
+type WalkerClassLike interface {
+	// Constructors
+	Make() WalkerLike
+}
+
 // Instances
 
 /*
@@ -585,9 +948,11 @@ by all attribute-like instances.
 */
 type AttributeLike interface {
 	// Attributes
+	GetComment() string
 	GetIdentifier() string
 	GetParameter() ParameterLike
 	GetAbstraction() AbstractionLike
+	GetDefault() string
 }
 
 /*
@@ -599,6 +964,32 @@ type AttributesLike interface {
 	GetSequence() col.Sequential[AttributeLike]
 }
 
+/*
+ChangeLike defines the set of abstractions and methods that must be supported by
+all change-like instances.
+*/
+type ChangeLike interface {
+	// Attributes
+	GetType() ChangeType
+	GetPath() string
+	GetDescription() string
+	IsBreaking() bool
+}
+
+/*
+ChangesLike defines the set of abstractions and methods that must be supported
+by all changes-like instances.
+*/
+type ChangesLike interface {
+	// Attributes
+	GetSequence() col.Sequential[ChangeLike]
+
+	// Methods
+	AsJSON() string
+	AsText() string
+	HasBreakingChanges() bool
+}
+
 /*
 ClassLike defines the set of abstractions and methods that must be supported by
 all class-like instances.
@@ -620,14 +1011,35 @@ type ClassesLike interface {
 	GetSequence() col.Sequential[ClassLike]
 }
 
+/*
+CodecLike defines the set of abstractions and methods that must be supported by
+all codec-like instances.
+*/
+type CodecLike interface {
+	// Methods
+	ExportJSON(model ModelLike) string
+	ImportJSON(json string) ModelLike
+}
+
+/*
+ComparatorLike defines the set of abstractions and methods that must be
+supported by all comparator-like instances.
+*/
+type ComparatorLike interface {
+	// Methods
+	CompareModels(original ModelLike, revised ModelLike) ChangesLike
+}
+
 /*
 ConstantLike defines the set of abstractions and methods that must be supported
 by all constant-like instances.
 */
 type ConstantLike interface {
 	// Attributes
+	GetComment() string
 	GetIdentifier() string
 	GetAbstraction() AbstractionLike
+	GetValue() string
 }
 
 /*
@@ -645,6 +1057,7 @@ supported by all constructor-like instances.
 */
 type ConstructorLike interface {
 	// Attributes
+	GetComment() string
 	GetIdentifier() string
 	GetParameters() ParametersLike
 	GetAbstraction() AbstractionLike
@@ -670,6 +1083,47 @@ type DeclarationLike interface {
 	GetParameters() ParametersLike
 }
 
+/*
+DiagnosticLike defines the set of abstractions and methods that must be
+supported by all diagnostic-like instances.
+*/
+type DiagnosticLike interface {
+	// Attributes
+	GetRule() RuleType
+	GetPath() string
+	GetDescription() string
+}
+
+/*
+DiagrammerLike defines the set of abstractions and methods that must be
+supported by all diagrammer-like instances.  A diagrammer-like instance renders
+the dependencies between the classes, instances, aspects and imported modules
+of a model as a diagram.
+*/
+type DiagrammerLike interface {
+	// Attributes
+	GetFormat() FormatType
+
+	// Methods
+	DiagramModel(model ModelLike) string
+}
+
+/*
+DocumenterLike defines the set of abstractions and methods that must be
+supported by all documenter-like instances.  A documenter-like instance renders
+the API reference documentation for a model as an index page and a linked page
+for each class.  A documenter-like instance may document several models
+concurrently.
+*/
+type DocumenterLike interface {
+	// Attributes
+	GetFormat() FormatType
+
+	// Methods
+	DocumentModel(model ModelLike) col.CatalogLike[string, string]
+	DocumentPackage(directory string)
+}
+
 /*
 EnumerationLike defines the set of abstractions and methods that must be
 supported by all enumeration-like instances.
@@ -701,6 +1155,7 @@ by all function-like instances.
 */
 type FunctionLike interface {
 	// Attributes
+	GetComment() string
 	GetIdentifier() string
 	GetParameters() ParametersLike
 	GetResult() ResultLike
@@ -737,7 +1192,18 @@ type FunctionsLike interface {
 
 /*
 GeneratorLike defines the set of abstractions and methods that must be
-supported by all generator-like instances.
+supported by all generator-like instances.  When the NilChecksOption is enabled
+each generated constructor panics if an argument with an interface type is nil,
+unless the comment of the constructor contains a line of the form
+"optional: parameter, parameter, ..." naming that parameter.  Unless the
+UncheckedOption is enabled, the generated package is type checked, using the
+module that contains it, before any of its files are written.  When the
+SerializationOption is enabled each class whose state is fully described by the
+parameters of its MakeWithAttributes constructor is encoded using both JSON and
+Crater Dog Collection Notation™ (CDCN), and each enumerated specialization is
+encoded using its value names.  A class file that
+was edited since it was generated is left alone, but the generation of a package
+fails if any of its other generated files was edited.
 */
 type GeneratorLike interface {
 	// Methods
@@ -806,6 +1272,7 @@ all method-like instances.
 */
 type MethodLike interface {
 	// Attributes
+	GetComment() string
 	GetIdentifier() string
 	GetParameters() ParametersLike
 	GetResult() ResultLike
@@ -868,6 +1335,7 @@ by all parameter-like instances.
 type ParameterLike interface {
 	// Attributes
 	GetIdentifier() string
+	IsVariadic() bool
 	GetAbstraction() AbstractionLike
 }
 
@@ -936,6 +1404,24 @@ type SpecializationsLike interface {
 	GetSequence() col.Sequential[SpecializationLike]
 }
 
+/*
+SubstitutorLike defines the set of abstractions and methods that must be
+supported by all substitutor-like instances.  A substitutor-like instance
+replaces the generic types of a declaration with the concrete types that were
+supplied as arguments wherever that declaration is used.
+*/
+type SubstitutorLike interface {
+	// Attributes
+	GetGenericTypes() ParametersLike
+	GetConcreteTypes() ArgumentsLike
+
+	// Methods
+	SubstituteAbstraction(abstraction AbstractionLike) AbstractionLike
+	SubstituteMethod(method MethodLike) MethodLike
+	SubstituteParameters(parameters ParametersLike) ParametersLike
+	SubstituteResult(result ResultLike) ResultLike
+}
+
 /*
 TokenLike defines the set of abstractions and methods that must be supported by
 all token-like instances.
@@ -960,10 +1446,17 @@ type TypesLike interface {
 
 /*
 ValidatorLike defines the set of abstractions and methods that must be
-supported by all validator-like instances.
+supported by all validator-like instances.  A validator-like instance may also
+check a model against its enabled lint rules.  Any node whose comment contains
+a line of the form "nolint: rule, rule, ..." is exempt from the named rules,
+and a comment on an interface or type declaration exempts all of its members.
+The suppression is placed in the block comment rather than in a trailing note
+since the grammar only allows notes as the fixed section headings.  The imports
+and functionals referenced by a default value annotation count as being used.
 */
 type ValidatorLike interface {
 	// Methods
+	LintModel(model ModelLike) col.Sequential[DiagnosticLike]
 	ValidateModel(model ModelLike)
 }
 
@@ -976,3 +1469,17 @@ type ValuesLike interface {
 	GetParameter() ParameterLike
 	GetSequence() col.Sequential[string]
 }
+
+/*
+WalkerLike defines the set of abstractions and methods that must be supported by
+all walker-like instances.  A walker-like instance walks the nodes of a model in
+order calling the hooks of a visitor.  It is also a visitor that ignores every
+node so that it can be embedded in a visitor that only handles some nodes.
+*/
+type WalkerLike interface {
+	// Abstractions
+	Visitor
+
+	// Methods
+	Walk(model ModelLike, visitor Visitor)
+}
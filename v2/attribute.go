@@ -41,14 +41,18 @@ type attributeClass_ struct {
 // Constructors
 
 func (c *attributeClass_) MakeWithAttributes(
+	comment string,
 	identifier string,
 	parameter ParameterLike,
 	abstraction AbstractionLike,
+	default_ string,
 ) AttributeLike {
 	return &attribute_{
+		comment_:     comment,
 		identifier_:  identifier,
 		parameter_:   parameter,
 		abstraction_: abstraction,
+		default_:     default_,
 	}
 }
 
@@ -59,13 +63,19 @@ func (c *attributeClass_) MakeWithAttributes(
 // Target
 
 type attribute_ struct {
+	comment_     string
 	identifier_  string
 	parameter_   ParameterLike
 	abstraction_ AbstractionLike
+	default_     string
 }
 
 // Attributes
 
+func (v *attribute_) GetComment() string {
+	return v.comment_
+}
+
 func (v *attribute_) GetIdentifier() string {
 	return v.identifier_
 }
@@ -78,6 +88,10 @@ func (v *attribute_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+func (v *attribute_) GetDefault() string {
+	return v.default_
+}
+
 // Public
 
 // Private
@@ -0,0 +1,95 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+// CLASS ACCESS
+
+// Reference
+
+var changeClass = &changeClass_{
+	strings_: map[ChangeType]string{
+		ErrorChange:    "error",
+		AddedChange:    "added",
+		ModifiedChange: "modified",
+		RemovedChange:  "removed",
+	},
+}
+
+// Function
+
+func Change() ChangeClassLike {
+	return changeClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type changeClass_ struct {
+	strings_ map[ChangeType]string
+}
+
+// Constructors
+
+func (c *changeClass_) MakeWithAttributes(
+	type_ ChangeType,
+	path string,
+	description string,
+	breaking bool,
+) ChangeLike {
+	return &change_{
+		type_:        type_,
+		path_:        path,
+		description_: description,
+		breaking_:    breaking,
+	}
+}
+
+// Functions
+
+func (c *changeClass_) AsString(type_ ChangeType) string {
+	return c.strings_[type_]
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type change_ struct {
+	type_        ChangeType
+	path_        string // The dot separated path to the changed model element.
+	description_ string
+	breaking_    bool // Whether the change breaks existing clients of the API.
+}
+
+// Attributes
+
+func (v *change_) GetType() ChangeType {
+	return v.type_
+}
+
+func (v *change_) GetPath() string {
+	return v.path_
+}
+
+func (v *change_) GetDescription() string {
+	return v.description_
+}
+
+func (v *change_) IsBreaking() bool {
+	return v.breaking_
+}
+
+// Public
+
+// Private
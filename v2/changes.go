@@ -0,0 +1,129 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var changesClass = &changesClass_{
+	// This class does not initialize any class constants.
+}
+
+// Function
+
+func Changes() ChangesClassLike {
+	return changesClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type changesClass_ struct {
+	// This class does not define any class constants.
+}
+
+// Constructors
+
+func (c *changesClass_) MakeWithAttributes(sequence col.Sequential[ChangeLike]) ChangesLike {
+	return &changes_{
+		sequence_: sequence,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type changes_ struct {
+	sequence_ col.Sequential[ChangeLike]
+}
+
+// Attributes
+
+func (v *changes_) GetSequence() col.Sequential[ChangeLike] {
+	return v.sequence_
+}
+
+// Public
+
+func (v *changes_) AsJSON() string {
+	type change struct {
+		Type        string `json:"type"`
+		Path        string `json:"path"`
+		Description string `json:"description"`
+		Breaking    bool   `json:"breaking"`
+	}
+	var report = struct {
+		Breaking bool     `json:"breaking"`
+		Changes  []change `json:"changes"`
+	}{
+		Breaking: v.HasBreakingChanges(),
+		Changes:  []change{},
+	}
+	var iterator = v.sequence_.GetIterator()
+	for iterator.HasNext() {
+		var next = iterator.GetNext()
+		report.Changes = append(report.Changes, change{
+			Type:        Change().AsString(next.GetType()),
+			Path:        next.GetPath(),
+			Description: next.GetDescription(),
+			Breaking:    next.IsBreaking(),
+		})
+	}
+	var bytes, err = jsn.MarshalIndent(report, "", "\t")
+	if err != nil {
+		panic(err)
+	}
+	return string(bytes) + "\n"
+}
+
+func (v *changes_) AsText() string {
+	var text string
+	var iterator = v.sequence_.GetIterator()
+	for iterator.HasNext() {
+		var change = iterator.GetNext()
+		var compatibility = "compatible"
+		if change.IsBreaking() {
+			compatibility = "breaking"
+		}
+		text += fmt.Sprintf(
+			"%-10v %-8v %v: %v\n",
+			compatibility,
+			Change().AsString(change.GetType()),
+			change.GetPath(),
+			change.GetDescription(),
+		)
+	}
+	return text
+}
+
+func (v *changes_) HasBreakingChanges() bool {
+	var iterator = v.sequence_.GetIterator()
+	for iterator.HasNext() {
+		var change = iterator.GetNext()
+		if change.IsBreaking() {
+			return true
+		}
+	}
+	return false
+}
+
+// Private
@@ -0,0 +1,889 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var codecClass = &codecClass_{
+	schemaVersion_: "1.0",
+}
+
+// Function
+
+func Codec() CodecClassLike {
+	return codecClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type codecClass_ struct {
+	schemaVersion_ string
+}
+
+// Constants
+
+func (c *codecClass_) SchemaVersion() string {
+	return c.schemaVersion_
+}
+
+// Constructors
+
+func (c *codecClass_) Make() CodecLike {
+	return &codec_{
+		// This class does not initialize any instance attributes.
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type codec_ struct {
+	// This class does not define any instance attributes.
+}
+
+// Public
+
+func (v *codec_) ExportJSON(model ModelLike) string {
+	var document = v.exportModel(model)
+	var bytes, err = jsn.MarshalIndent(document, "", "\t")
+	if err != nil {
+		panic(err)
+	}
+	return string(bytes) + "\n"
+}
+
+func (v *codec_) ImportJSON(json string) ModelLike {
+	var document modelJSON_
+	var err = jsn.Unmarshal([]byte(json), &document)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The JSON document does not contain a valid model: %v",
+			err,
+		)
+		panic(message)
+	}
+	// Only the major version of the schema determines compatibility.
+	var major = sts.Split(Codec().SchemaVersion(), ".")[0]
+	if sts.Split(document.Version, ".")[0] != major {
+		var message = fmt.Sprintf(
+			"The JSON schema version of the model is not supported: %v",
+			document.Version,
+		)
+		panic(message)
+	}
+	return v.importModel(&document)
+}
+
+// Private
+
+func (v *codec_) exportAbstraction(abstraction AbstractionLike) *abstractionJSON_ {
+	var result = &abstractionJSON_{
+		Identifier: abstraction.GetIdentifier(),
+	}
+	var prefix = abstraction.GetPrefix()
+	if prefix != nil {
+		result.Prefix = &prefixJSON_{
+			Type:       v.exportPrefixType(prefix.GetType()),
+			Identifier: prefix.GetIdentifier(),
+		}
+	}
+	var arguments = abstraction.GetArguments()
+	if arguments != nil {
+		var iterator = arguments.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var argument = v.exportAbstraction(iterator.GetNext())
+			result.Arguments = append(result.Arguments, argument)
+		}
+	}
+	return result
+}
+
+func (v *codec_) exportAbstractions(abstractions AbstractionsLike) []*abstractionJSON_ {
+	var result []*abstractionJSON_
+	if abstractions == nil {
+		return result
+	}
+	var iterator = abstractions.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		result = append(result, v.exportAbstraction(iterator.GetNext()))
+	}
+	return result
+}
+
+func (v *codec_) exportDeclaration(declaration DeclarationLike) *declarationJSON_ {
+	return &declarationJSON_{
+		Comment:    declaration.GetComment(),
+		Identifier: declaration.GetIdentifier(),
+		Parameters: v.exportParameters(declaration.GetParameters()),
+	}
+}
+
+func (v *codec_) exportInterfaces(interfaces InterfacesLike) *interfacesJSON_ {
+	var result = &interfacesJSON_{}
+	var aspects = interfaces.GetAspects()
+	if aspects != nil {
+		var iterator = aspects.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var aspect = iterator.GetNext()
+			result.Aspects = append(result.Aspects, &aspectJSON_{
+				Declaration: v.exportDeclaration(aspect.GetDeclaration()),
+				Methods:     v.exportMethods(aspect.GetMethods()),
+			})
+		}
+	}
+	var classes = interfaces.GetClasses()
+	if classes != nil {
+		var iterator = classes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			result.Classes = append(result.Classes, v.exportClass(iterator.GetNext()))
+		}
+	}
+	var instances = interfaces.GetInstances()
+	if instances != nil {
+		var iterator = instances.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			result.Instances = append(result.Instances, v.exportInstance(iterator.GetNext()))
+		}
+	}
+	return result
+}
+
+func (v *codec_) exportClass(class ClassLike) *classJSON_ {
+	var result = &classJSON_{
+		Declaration: v.exportDeclaration(class.GetDeclaration()),
+	}
+	var constants = class.GetConstants()
+	if constants != nil {
+		var iterator = constants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			result.Constants = append(result.Constants, &constantJSON_{
+				Comment:     constant.GetComment(),
+				Identifier:  constant.GetIdentifier(),
+				Abstraction: v.exportAbstraction(constant.GetAbstraction()),
+				Value:       constant.GetValue(),
+			})
+		}
+	}
+	var constructors = class.GetConstructors()
+	if constructors != nil {
+		var iterator = constructors.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constructor = iterator.GetNext()
+			result.Constructors = append(result.Constructors, &constructorJSON_{
+				Comment:     constructor.GetComment(),
+				Identifier:  constructor.GetIdentifier(),
+				Parameters:  v.exportParameters(constructor.GetParameters()),
+				Abstraction: v.exportAbstraction(constructor.GetAbstraction()),
+			})
+		}
+	}
+	var functions = class.GetFunctions()
+	if functions != nil {
+		var iterator = functions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var function = iterator.GetNext()
+			result.Functions = append(result.Functions, &methodJSON_{
+				Comment:    function.GetComment(),
+				Identifier: function.GetIdentifier(),
+				Parameters: v.exportParameters(function.GetParameters()),
+				Result:     v.exportResult(function.GetResult()),
+			})
+		}
+	}
+	return result
+}
+
+func (v *codec_) exportInstance(instance InstanceLike) *instanceJSON_ {
+	var result = &instanceJSON_{
+		Declaration:  v.exportDeclaration(instance.GetDeclaration()),
+		Abstractions: v.exportAbstractions(instance.GetAbstractions()),
+		Methods:      v.exportMethods(instance.GetMethods()),
+	}
+	var attributes = instance.GetAttributes()
+	if attributes != nil {
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			var attributeJSON = &attributeJSON_{
+				Comment:    attribute.GetComment(),
+				Identifier: attribute.GetIdentifier(),
+				Default:    attribute.GetDefault(),
+			}
+			var parameter = attribute.GetParameter()
+			if parameter != nil {
+				attributeJSON.Parameter = v.exportParameter(parameter)
+			}
+			var abstraction = attribute.GetAbstraction()
+			if abstraction != nil {
+				attributeJSON.Abstraction = v.exportAbstraction(abstraction)
+			}
+			result.Attributes = append(result.Attributes, attributeJSON)
+		}
+	}
+	return result
+}
+
+func (v *codec_) exportMethods(methods MethodsLike) []*methodJSON_ {
+	var result []*methodJSON_
+	if methods == nil {
+		return result
+	}
+	var iterator = methods.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var method = iterator.GetNext()
+		result = append(result, &methodJSON_{
+			Comment:    method.GetComment(),
+			Identifier: method.GetIdentifier(),
+			Parameters: v.exportParameters(method.GetParameters()),
+			Result:     v.exportResult(method.GetResult()),
+		})
+	}
+	return result
+}
+
+func (v *codec_) exportModel(model ModelLike) *modelJSON_ {
+	var header = model.GetHeader()
+	var result = &modelJSON_{
+		Version: Codec().SchemaVersion(),
+		Notice:  model.GetNotice().GetComment(),
+		Header: &headerJSON_{
+			Comment:    header.GetComment(),
+			Identifier: header.GetIdentifier(),
+		},
+	}
+	var imports = model.GetImports()
+	if imports != nil {
+		result.Imports = &importsJSON_{}
+		var modules = imports.GetModules()
+		if modules != nil {
+			var iterator = modules.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var module = iterator.GetNext()
+				result.Imports.Modules = append(result.Imports.Modules, &moduleJSON_{
+					Identifier: module.GetIdentifier(),
+					Text:       module.GetText(),
+				})
+			}
+		}
+	}
+	var types = model.GetTypes()
+	if types != nil {
+		result.Types = v.exportTypes(types)
+	}
+	var interfaces = model.GetInterfaces()
+	if interfaces != nil {
+		result.Interfaces = v.exportInterfaces(interfaces)
+	}
+	return result
+}
+
+func (v *codec_) exportParameter(parameter ParameterLike) *parameterJSON_ {
+	return &parameterJSON_{
+		Identifier:  parameter.GetIdentifier(),
+		Variadic:    parameter.IsVariadic(),
+		Abstraction: v.exportAbstraction(parameter.GetAbstraction()),
+	}
+}
+
+func (v *codec_) exportParameters(parameters ParametersLike) []*parameterJSON_ {
+	var result []*parameterJSON_
+	if parameters == nil {
+		return result
+	}
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		result = append(result, v.exportParameter(iterator.GetNext()))
+	}
+	return result
+}
+
+func (v *codec_) exportPrefixType(type_ PrefixType) string {
+	var result string
+	switch type_ {
+	case AliasPrefix:
+		result = "alias"
+	case ArrayPrefix:
+		result = "array"
+	case ChannelPrefix:
+		result = "channel"
+	case MapPrefix:
+		result = "map"
+	}
+	return result
+}
+
+func (v *codec_) exportResult(result ResultLike) *resultJSON_ {
+	if result == nil {
+		return nil
+	}
+	var abstraction = result.GetAbstraction()
+	if abstraction != nil {
+		return &resultJSON_{
+			Abstraction: v.exportAbstraction(abstraction),
+		}
+	}
+	return &resultJSON_{
+		Parameters: v.exportParameters(result.GetParameters()),
+	}
+}
+
+func (v *codec_) exportTypes(types TypesLike) *typesJSON_ {
+	var result = &typesJSON_{}
+	var specializations = types.GetSpecializations()
+	if specializations != nil {
+		var iterator = specializations.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var specialization = iterator.GetNext()
+			var specializationJSON = &specializationJSON_{
+				Declaration: v.exportDeclaration(specialization.GetDeclaration()),
+				Abstraction: v.exportAbstraction(specialization.GetAbstraction()),
+			}
+			var enumeration = specialization.GetEnumeration()
+			if enumeration != nil {
+				var values = enumeration.GetValues()
+				specializationJSON.Enumeration = &enumerationJSON_{
+					Parameter: v.exportParameter(values.GetParameter()),
+					Values:    values.GetSequence().AsArray(),
+				}
+			}
+			result.Specializations = append(result.Specializations, specializationJSON)
+		}
+	}
+	var functionals = types.GetFunctionals()
+	if functionals != nil {
+		var iterator = functionals.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var functional = iterator.GetNext()
+			result.Functionals = append(result.Functionals, &functionalJSON_{
+				Declaration: v.exportDeclaration(functional.GetDeclaration()),
+				Parameters:  v.exportParameters(functional.GetParameters()),
+				Result:      v.exportResult(functional.GetResult()),
+			})
+		}
+	}
+	return result
+}
+
+func (v *codec_) importAbstraction(
+	abstraction *abstractionJSON_,
+	path string,
+) AbstractionLike {
+	v.requireField(abstraction == nil, path)
+	v.requireField(abstraction.Identifier == "", path+".identifier")
+	var prefix PrefixLike
+	if abstraction.Prefix != nil {
+		prefix = Prefix().MakeWithAttributes(
+			abstraction.Prefix.Identifier,
+			v.importPrefixType(abstraction.Prefix.Type),
+		)
+	}
+	var arguments ArgumentsLike
+	if len(abstraction.Arguments) > 0 {
+		var sequence = col.List[AbstractionLike]().Make()
+		for index, argument := range abstraction.Arguments {
+			var site = fmt.Sprintf("%v.arguments[%v]", path, index)
+			sequence.AppendValue(v.importAbstraction(argument, site))
+		}
+		arguments = Arguments().MakeWithAttributes(sequence)
+	}
+	return Abstraction().MakeWithAttributes(
+		prefix,
+		abstraction.Identifier,
+		arguments,
+	)
+}
+
+func (v *codec_) importAbstractions(
+	abstractions []*abstractionJSON_,
+	path string,
+) AbstractionsLike {
+	if len(abstractions) == 0 {
+		return nil
+	}
+	var sequence = col.List[AbstractionLike]().Make()
+	for index, abstraction := range abstractions {
+		var site = fmt.Sprintf("%v[%v]", path, index)
+		sequence.AppendValue(v.importAbstraction(abstraction, site))
+	}
+	return Abstractions().MakeWithAttributes(sequence)
+}
+
+func (v *codec_) importClass(class *classJSON_, path string) ClassLike {
+	v.requireField(class == nil, path)
+	var constants ConstantsLike
+	if len(class.Constants) > 0 {
+		var sequence = col.List[ConstantLike]().Make()
+		for index, constant := range class.Constants {
+			var site = fmt.Sprintf("%v.constants[%v]", path, index)
+			v.requireField(constant == nil, site)
+			v.requireField(constant.Identifier == "", site+".identifier")
+			sequence.AppendValue(Constant().MakeWithAttributes(
+				constant.Comment,
+				constant.Identifier,
+				v.importAbstraction(constant.Abstraction, site+".abstraction"),
+				constant.Value,
+			))
+		}
+		constants = Constants().MakeWithAttributes(sequence)
+	}
+	var constructors ConstructorsLike
+	if len(class.Constructors) > 0 {
+		var sequence = col.List[ConstructorLike]().Make()
+		for index, constructor := range class.Constructors {
+			var site = fmt.Sprintf("%v.constructors[%v]", path, index)
+			v.requireField(constructor == nil, site)
+			v.requireField(constructor.Identifier == "", site+".identifier")
+			sequence.AppendValue(Constructor().MakeWithAttributes(
+				constructor.Comment,
+				constructor.Identifier,
+				v.importParameters(constructor.Parameters, site+".parameters"),
+				v.importAbstraction(constructor.Abstraction, site+".abstraction"),
+			))
+		}
+		constructors = Constructors().MakeWithAttributes(sequence)
+	}
+	var functions FunctionsLike
+	if len(class.Functions) > 0 {
+		var sequence = col.List[FunctionLike]().Make()
+		for index, function := range class.Functions {
+			var site = fmt.Sprintf("%v.functions[%v]", path, index)
+			v.requireField(function == nil, site)
+			v.requireField(function.Identifier == "", site+".identifier")
+			v.requireField(function.Result == nil, site+".result")
+			sequence.AppendValue(Function().MakeWithAttributes(
+				function.Comment,
+				function.Identifier,
+				v.importParameters(function.Parameters, site+".parameters"),
+				v.importResult(function.Result, site+".result"),
+			))
+		}
+		functions = Functions().MakeWithAttributes(sequence)
+	}
+	return Class().MakeWithAttributes(
+		v.importDeclaration(class.Declaration, path+".declaration"),
+		constants,
+		constructors,
+		functions,
+	)
+}
+
+func (v *codec_) importDeclaration(
+	declaration *declarationJSON_,
+	path string,
+) DeclarationLike {
+	v.requireField(declaration == nil, path)
+	v.requireField(declaration.Identifier == "", path+".identifier")
+	return Declaration().MakeWithAttributes(
+		declaration.Comment,
+		declaration.Identifier,
+		v.importParameters(declaration.Parameters, path+".parameters"),
+	)
+}
+
+func (v *codec_) importInstance(instance *instanceJSON_, path string) InstanceLike {
+	v.requireField(instance == nil, path)
+	var attributes AttributesLike
+	if len(instance.Attributes) > 0 {
+		var sequence = col.List[AttributeLike]().Make()
+		for index, attribute := range instance.Attributes {
+			var site = fmt.Sprintf("%v.attributes[%v]", path, index)
+			v.requireField(attribute == nil, site)
+			v.requireField(attribute.Identifier == "", site+".identifier")
+			var isSetter = sts.HasPrefix(attribute.Identifier, "Set")
+			v.requireField(isSetter && attribute.Parameter == nil, site+".parameter")
+			v.requireField(!isSetter && attribute.Abstraction == nil, site+".abstraction")
+			var parameter ParameterLike
+			if attribute.Parameter != nil {
+				parameter = v.importParameter(attribute.Parameter, site+".parameter")
+			}
+			var abstraction AbstractionLike
+			if attribute.Abstraction != nil {
+				abstraction = v.importAbstraction(
+					attribute.Abstraction,
+					site+".abstraction",
+				)
+			}
+			sequence.AppendValue(Attribute().MakeWithAttributes(
+				attribute.Comment,
+				attribute.Identifier,
+				parameter,
+				abstraction,
+				attribute.Default,
+			))
+		}
+		attributes = Attributes().MakeWithAttributes(sequence)
+	}
+	return Instance().MakeWithAttributes(
+		v.importDeclaration(instance.Declaration, path+".declaration"),
+		attributes,
+		v.importAbstractions(instance.Abstractions, path+".abstractions"),
+		v.importMethods(instance.Methods, path+".methods"),
+	)
+}
+
+func (v *codec_) importInterfaces(
+	interfaces *interfacesJSON_,
+	path string,
+) InterfacesLike {
+	var aspects AspectsLike
+	if len(interfaces.Aspects) > 0 {
+		var sequence = col.List[AspectLike]().Make()
+		for index, aspect := range interfaces.Aspects {
+			var site = fmt.Sprintf("%v.aspects[%v]", path, index)
+			v.requireField(aspect == nil, site)
+			sequence.AppendValue(Aspect().MakeWithAttributes(
+				v.importDeclaration(aspect.Declaration, site+".declaration"),
+				v.importMethods(aspect.Methods, site+".methods"),
+			))
+		}
+		aspects = Aspects().MakeWithAttributes(sequence)
+	}
+	var classes ClassesLike
+	if len(interfaces.Classes) > 0 {
+		var sequence = col.List[ClassLike]().Make()
+		for index, class := range interfaces.Classes {
+			var site = fmt.Sprintf("%v.classes[%v]", path, index)
+			sequence.AppendValue(v.importClass(class, site))
+		}
+		classes = Classes().MakeWithAttributes(sequence)
+	}
+	var instances InstancesLike
+	if len(interfaces.Instances) > 0 {
+		var sequence = col.List[InstanceLike]().Make()
+		for index, instance := range interfaces.Instances {
+			var site = fmt.Sprintf("%v.instances[%v]", path, index)
+			sequence.AppendValue(v.importInstance(instance, site))
+		}
+		instances = Instances().MakeWithAttributes(sequence)
+	}
+	return Interfaces().MakeWithAttributes(aspects, classes, instances)
+}
+
+func (v *codec_) importMethods(methods []*methodJSON_, path string) MethodsLike {
+	if len(methods) == 0 {
+		return nil
+	}
+	var sequence = col.List[MethodLike]().Make()
+	for index, method := range methods {
+		var site = fmt.Sprintf("%v[%v]", path, index)
+		v.requireField(method == nil, site)
+		v.requireField(method.Identifier == "", site+".identifier")
+		var result ResultLike
+		if method.Result != nil {
+			result = v.importResult(method.Result, site+".result")
+		}
+		sequence.AppendValue(Method().MakeWithAttributes(
+			method.Comment,
+			method.Identifier,
+			v.importParameters(method.Parameters, site+".parameters"),
+			result,
+		))
+	}
+	return Methods().MakeWithAttributes(sequence)
+}
+
+func (v *codec_) importModel(model *modelJSON_) ModelLike {
+	v.requireField(model.Header == nil, "header")
+	v.requireField(model.Header.Identifier == "", "header.identifier")
+	var notice = Notice().MakeWithAttributes(model.Notice)
+	var header = Header().MakeWithAttributes(
+		model.Header.Comment,
+		model.Header.Identifier,
+	)
+	var imports ImportsLike
+	if model.Imports != nil {
+		var modules ModulesLike
+		if len(model.Imports.Modules) > 0 {
+			var sequence = col.List[ModuleLike]().Make()
+			for index, module := range model.Imports.Modules {
+				var site = fmt.Sprintf("imports.modules[%v]", index)
+				v.requireField(module == nil, site)
+				v.requireField(module.Identifier == "", site+".identifier")
+				v.requireField(module.Text == "", site+".text")
+				sequence.AppendValue(Module().MakeWithAttributes(
+					module.Identifier,
+					module.Text,
+				))
+			}
+			modules = Modules().MakeWithAttributes(sequence)
+		}
+		imports = Imports().MakeWithAttributes(modules)
+	}
+	var types TypesLike
+	if model.Types != nil {
+		types = v.importTypes(model.Types, "types")
+	}
+	var interfaces InterfacesLike
+	if model.Interfaces != nil {
+		interfaces = v.importInterfaces(model.Interfaces, "interfaces")
+	}
+	return Model().MakeWithAttributes(notice, header, imports, types, interfaces)
+}
+
+func (v *codec_) importParameter(
+	parameter *parameterJSON_,
+	path string,
+) ParameterLike {
+	v.requireField(parameter == nil, path)
+	v.requireField(parameter.Identifier == "", path+".identifier")
+	return Parameter().MakeWithAttributes(
+		parameter.Identifier,
+		parameter.Variadic,
+		v.importAbstraction(parameter.Abstraction, path+".abstraction"),
+	)
+}
+
+func (v *codec_) importParameters(
+	parameters []*parameterJSON_,
+	path string,
+) ParametersLike {
+	if len(parameters) == 0 {
+		return nil
+	}
+	var sequence = col.List[ParameterLike]().Make()
+	for index, parameter := range parameters {
+		var site = fmt.Sprintf("%v[%v]", path, index)
+		sequence.AppendValue(v.importParameter(parameter, site))
+	}
+	return Parameters().MakeWithAttributes(sequence)
+}
+
+func (v *codec_) importPrefixType(type_ string) PrefixType {
+	var result PrefixType
+	switch type_ {
+	case "alias":
+		result = AliasPrefix
+	case "array":
+		result = ArrayPrefix
+	case "channel":
+		result = ChannelPrefix
+	case "map":
+		result = MapPrefix
+	default:
+		var message = fmt.Sprintf(
+			"An unknown prefix type was found in the JSON model: %v",
+			type_,
+		)
+		panic(message)
+	}
+	return result
+}
+
+func (v *codec_) importResult(result *resultJSON_, path string) ResultLike {
+	if result.Abstraction != nil {
+		var abstraction = v.importAbstraction(
+			result.Abstraction,
+			path+".abstraction",
+		)
+		return Result().MakeWithAbstraction(abstraction)
+	}
+	v.requireField(len(result.Parameters) == 0, path+".abstraction")
+	var parameters = v.importParameters(result.Parameters, path+".parameters")
+	return Result().MakeWithParameters(parameters)
+}
+
+func (v *codec_) importTypes(types *typesJSON_, path string) TypesLike {
+	var specializations SpecializationsLike
+	if len(types.Specializations) > 0 {
+		var sequence = col.List[SpecializationLike]().Make()
+		for index, specialization := range types.Specializations {
+			var site = fmt.Sprintf("%v.specializations[%v]", path, index)
+			v.requireField(specialization == nil, site)
+			var enumeration EnumerationLike
+			if specialization.Enumeration != nil {
+				var values = Values().MakeWithAttributes(
+					v.importParameter(
+						specialization.Enumeration.Parameter,
+						site+".enumeration.parameter",
+					),
+					col.List[string]().MakeFromArray(specialization.Enumeration.Values),
+				)
+				enumeration = Enumeration().MakeWithAttributes(values)
+			}
+			sequence.AppendValue(Specialization().MakeWithAttributes(
+				v.importDeclaration(specialization.Declaration, site+".declaration"),
+				v.importAbstraction(specialization.Abstraction, site+".abstraction"),
+				enumeration,
+			))
+		}
+		specializations = Specializations().MakeWithAttributes(sequence)
+	}
+	var functionals FunctionalsLike
+	if len(types.Functionals) > 0 {
+		var sequence = col.List[FunctionalLike]().Make()
+		for index, functional := range types.Functionals {
+			var site = fmt.Sprintf("%v.functionals[%v]", path, index)
+			v.requireField(functional == nil, site)
+			v.requireField(functional.Result == nil, site+".result")
+			sequence.AppendValue(Functional().MakeWithAttributes(
+				v.importDeclaration(functional.Declaration, site+".declaration"),
+				v.importParameters(functional.Parameters, site+".parameters"),
+				v.importResult(functional.Result, site+".result"),
+			))
+		}
+		functionals = Functionals().MakeWithAttributes(sequence)
+	}
+	return Types().MakeWithAttributes(specializations, functionals)
+}
+
+func (v *codec_) requireField(missing bool, path string) {
+	if missing {
+		var message = fmt.Sprintf(
+			"The JSON model is missing the following required field: %v",
+			path,
+		)
+		panic(message)
+	}
+}
+
+// The following types define the versioned JSON schema for a model.
+
+type abstractionJSON_ struct {
+	Prefix     *prefixJSON_        `json:"prefix,omitempty"`
+	Identifier string              `json:"identifier"`
+	Arguments  []*abstractionJSON_ `json:"arguments,omitempty"`
+}
+
+type aspectJSON_ struct {
+	Declaration *declarationJSON_ `json:"declaration"`
+	Methods     []*methodJSON_    `json:"methods,omitempty"`
+}
+
+type attributeJSON_ struct {
+	Comment     string            `json:"comment,omitempty"`
+	Identifier  string            `json:"identifier"`
+	Parameter   *parameterJSON_   `json:"parameter,omitempty"`
+	Abstraction *abstractionJSON_ `json:"abstraction,omitempty"`
+	Default     string            `json:"default,omitempty"`
+}
+
+type classJSON_ struct {
+	Declaration  *declarationJSON_   `json:"declaration"`
+	Constants    []*constantJSON_    `json:"constants,omitempty"`
+	Constructors []*constructorJSON_ `json:"constructors,omitempty"`
+	Functions    []*methodJSON_      `json:"functions,omitempty"`
+}
+
+type constantJSON_ struct {
+	Comment     string            `json:"comment,omitempty"`
+	Identifier  string            `json:"identifier"`
+	Abstraction *abstractionJSON_ `json:"abstraction"`
+	Value       string            `json:"value,omitempty"`
+}
+
+type constructorJSON_ struct {
+	Comment     string            `json:"comment,omitempty"`
+	Identifier  string            `json:"identifier"`
+	Parameters  []*parameterJSON_ `json:"parameters,omitempty"`
+	Abstraction *abstractionJSON_ `json:"abstraction"`
+}
+
+type declarationJSON_ struct {
+	Comment    string            `json:"comment"`
+	Identifier string            `json:"identifier"`
+	Parameters []*parameterJSON_ `json:"parameters,omitempty"`
+}
+
+type enumerationJSON_ struct {
+	Parameter *parameterJSON_ `json:"parameter"`
+	Values    []string        `json:"values,omitempty"`
+}
+
+type functionalJSON_ struct {
+	Declaration *declarationJSON_ `json:"declaration"`
+	Parameters  []*parameterJSON_ `json:"parameters,omitempty"`
+	Result      *resultJSON_      `json:"result"`
+}
+
+type headerJSON_ struct {
+	Comment    string `json:"comment"`
+	Identifier string `json:"identifier"`
+}
+
+type importsJSON_ struct {
+	Modules []*moduleJSON_ `json:"modules,omitempty"`
+}
+
+type instanceJSON_ struct {
+	Declaration  *declarationJSON_   `json:"declaration"`
+	Attributes   []*attributeJSON_   `json:"attributes,omitempty"`
+	Abstractions []*abstractionJSON_ `json:"abstractions,omitempty"`
+	Methods      []*methodJSON_      `json:"methods,omitempty"`
+}
+
+type interfacesJSON_ struct {
+	Aspects   []*aspectJSON_   `json:"aspects,omitempty"`
+	Classes   []*classJSON_    `json:"classes,omitempty"`
+	Instances []*instanceJSON_ `json:"instances,omitempty"`
+}
+
+type methodJSON_ struct {
+	Comment    string            `json:"comment,omitempty"`
+	Identifier string            `json:"identifier"`
+	Parameters []*parameterJSON_ `json:"parameters,omitempty"`
+	Result     *resultJSON_      `json:"result,omitempty"`
+}
+
+type modelJSON_ struct {
+	Version    string           `json:"version"`
+	Notice     string           `json:"notice"`
+	Header     *headerJSON_     `json:"header"`
+	Imports    *importsJSON_    `json:"imports,omitempty"`
+	Types      *typesJSON_      `json:"types,omitempty"`
+	Interfaces *interfacesJSON_ `json:"interfaces,omitempty"`
+}
+
+type moduleJSON_ struct {
+	Identifier string `json:"identifier"`
+	Text       string `json:"text"`
+}
+
+type parameterJSON_ struct {
+	Identifier  string            `json:"identifier"`
+	Variadic    bool              `json:"variadic,omitempty"`
+	Abstraction *abstractionJSON_ `json:"abstraction"`
+}
+
+type prefixJSON_ struct {
+	Type       string `json:"type"`
+	Identifier string `json:"identifier,omitempty"`
+}
+
+type resultJSON_ struct {
+	Abstraction *abstractionJSON_ `json:"abstraction,omitempty"`
+	Parameters  []*parameterJSON_ `json:"parameters,omitempty"`
+}
+
+type specializationJSON_ struct {
+	Declaration *declarationJSON_ `json:"declaration"`
+	Abstraction *abstractionJSON_ `json:"abstraction"`
+	Enumeration *enumerationJSON_ `json:"enumeration,omitempty"`
+}
+
+type typesJSON_ struct {
+	Specializations []*specializationJSON_ `json:"specializations,omitempty"`
+	Functionals     []*functionalJSON_     `json:"functionals,omitempty"`
+}
@@ -0,0 +1,879 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var comparatorClass = &comparatorClass_{
+	// This class does not initialize any class constants.
+}
+
+// Function
+
+func Comparator() ComparatorClassLike {
+	return comparatorClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type comparatorClass_ struct {
+	// This class does not define any class constants.
+}
+
+// Constructors
+
+func (c *comparatorClass_) Make() ComparatorLike {
+	return &comparator_{
+		changes_: col.List[ChangeLike]().Make(),
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type comparator_ struct {
+	changes_ col.ListLike[ChangeLike]
+}
+
+// Public
+
+func (v *comparator_) CompareModels(
+	original ModelLike,
+	revised ModelLike,
+) ChangesLike {
+	v.changes_ = col.List[ChangeLike]().Make()
+	v.compareHeaders(original.GetHeader(), revised.GetHeader())
+	v.compareSpecializations(
+		v.extractSpecializations(original),
+		v.extractSpecializations(revised),
+	)
+	v.compareFunctionals(
+		v.extractFunctionals(original),
+		v.extractFunctionals(revised),
+	)
+	v.compareAspects(
+		v.extractAspects(original),
+		v.extractAspects(revised),
+	)
+	v.compareClasses(
+		v.extractClasses(original),
+		v.extractClasses(revised),
+	)
+	v.compareInstances(
+		v.extractInstances(original),
+		v.extractInstances(revised),
+	)
+	return Changes().MakeWithAttributes(v.changes_)
+}
+
+// Private
+
+func (v *comparator_) addChange(
+	type_ ChangeType,
+	path string,
+	description string,
+	breaking bool,
+) {
+	var change = Change().MakeWithAttributes(type_, path, description, breaking)
+	v.changes_.AppendValue(change)
+}
+
+func (v *comparator_) compareAbstractions(
+	path string,
+	originals col.CatalogLike[string, AbstractionLike],
+	revisions col.CatalogLike[string, AbstractionLike],
+) {
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if revisions.GetValue(name) == nil {
+			var description = fmt.Sprintf("The %v abstraction was removed.", name)
+			v.addChange(RemovedChange, path, description, true)
+		}
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if originals.GetValue(name) == nil {
+			// An added abstraction adds methods to the instance interface.
+			var description = fmt.Sprintf("The %v abstraction was added.", name)
+			v.addChange(AddedChange, path, description, true)
+		}
+	}
+}
+
+func (v *comparator_) compareAspects(
+	originals col.CatalogLike[string, AspectLike],
+	revisions col.CatalogLike[string, AspectLike],
+) {
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var original = association.GetValue()
+		var revision = revisions.GetValue(name)
+		if revision == nil {
+			v.addChange(RemovedChange, name, "The aspect was removed.", true)
+			continue
+		}
+		v.compareDeclarations(
+			name,
+			original.GetDeclaration(),
+			revision.GetDeclaration(),
+		)
+		v.compareMembers(
+			name,
+			"method",
+			v.extractMethods(original.GetMethods()),
+			v.extractMethods(revision.GetMethods()),
+		)
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if originals.GetValue(name) == nil {
+			v.addChange(AddedChange, name, "The aspect was added.", false)
+		}
+	}
+}
+
+func (v *comparator_) compareClasses(
+	originals col.CatalogLike[string, ClassLike],
+	revisions col.CatalogLike[string, ClassLike],
+) {
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var original = association.GetValue()
+		var revision = revisions.GetValue(name)
+		if revision == nil {
+			v.addChange(RemovedChange, name, "The class was removed.", true)
+			continue
+		}
+		v.compareDeclarations(
+			name,
+			original.GetDeclaration(),
+			revision.GetDeclaration(),
+		)
+		v.compareMembers(
+			name,
+			"constant",
+			v.extractConstants(original.GetConstants()),
+			v.extractConstants(revision.GetConstants()),
+		)
+		v.compareValues(
+			name,
+			"constant value",
+			v.extractConstantValues(original.GetConstants()),
+			v.extractConstantValues(revision.GetConstants()),
+		)
+		v.compareMembers(
+			name,
+			"constructor",
+			v.extractConstructors(original.GetConstructors()),
+			v.extractConstructors(revision.GetConstructors()),
+		)
+		v.compareMembers(
+			name,
+			"function",
+			v.extractFunctions(original.GetFunctions()),
+			v.extractFunctions(revision.GetFunctions()),
+		)
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if originals.GetValue(name) == nil {
+			v.addChange(AddedChange, name, "The class was added.", false)
+		}
+	}
+}
+
+func (v *comparator_) compareDeclarations(
+	path string,
+	original DeclarationLike,
+	revision DeclarationLike,
+) {
+	var originalParameters = original.GetParameters()
+	var revisedParameters = revision.GetParameters()
+	var originalTypes = v.formatParameterTypes(originalParameters)
+	var revisedTypes = v.formatParameterTypes(revisedParameters)
+	var originalText = v.formatParameters(originalParameters)
+	var revisedText = v.formatParameters(revisedParameters)
+	switch {
+	case originalTypes != revisedTypes:
+		var description = fmt.Sprintf(
+			"The generic parameters changed from [%v] to [%v].",
+			originalText,
+			revisedText,
+		)
+		v.addChange(ModifiedChange, path, description, true)
+	case originalText != revisedText:
+		var description = fmt.Sprintf(
+			"The generic parameter names changed from [%v] to [%v].",
+			originalText,
+			revisedText,
+		)
+		v.addChange(ModifiedChange, path, description, false)
+	}
+}
+
+func (v *comparator_) compareEnumerations(
+	path string,
+	original EnumerationLike,
+	revision EnumerationLike,
+) {
+	var originals = v.extractValues(original)
+	var revisions = v.extractValues(revision)
+	var originalSize = originals.GetSize()
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var originalIndex = association.GetValue()
+		var revisedIndex = revisions.GetValue(name)
+		switch {
+		case revisedIndex == 0:
+			v.addChange(
+				RemovedChange,
+				path+"."+name,
+				"The enumeration value was removed.",
+				true,
+			)
+		case revisedIndex != originalIndex:
+			// The value of an enumeration constant depends on its position.
+			var description = fmt.Sprintf(
+				"The enumeration value moved from position %v to %v.",
+				originalIndex,
+				revisedIndex,
+			)
+			v.addChange(ModifiedChange, path+"."+name, description, true)
+		}
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var revisedIndex = association.GetValue()
+		if originals.GetValue(name) == 0 {
+			// Only appending a new value leaves the existing values unchanged.
+			var isBreaking = revisedIndex <= originalSize
+			v.addChange(
+				AddedChange,
+				path+"."+name,
+				"The enumeration value was added.",
+				isBreaking,
+			)
+		}
+	}
+}
+
+func (v *comparator_) compareFunctionals(
+	originals col.CatalogLike[string, FunctionalLike],
+	revisions col.CatalogLike[string, FunctionalLike],
+) {
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var original = association.GetValue()
+		var revision = revisions.GetValue(name)
+		if revision == nil {
+			v.addChange(RemovedChange, name, "The functional type was removed.", true)
+			continue
+		}
+		v.compareDeclarations(
+			name,
+			original.GetDeclaration(),
+			revision.GetDeclaration(),
+		)
+		v.compareSignatures(
+			name,
+			original.GetParameters(),
+			original.GetResult(),
+			revision.GetParameters(),
+			revision.GetResult(),
+		)
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if originals.GetValue(name) == nil {
+			v.addChange(AddedChange, name, "The functional type was added.", false)
+		}
+	}
+}
+
+func (v *comparator_) compareHeaders(original HeaderLike, revision HeaderLike) {
+	var originalName = original.GetIdentifier()
+	var revisedName = revision.GetIdentifier()
+	if originalName != revisedName {
+		var description = fmt.Sprintf(
+			"The package name changed from %v to %v.",
+			originalName,
+			revisedName,
+		)
+		v.addChange(ModifiedChange, "package", description, true)
+	}
+}
+
+func (v *comparator_) compareInstances(
+	originals col.CatalogLike[string, InstanceLike],
+	revisions col.CatalogLike[string, InstanceLike],
+) {
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var original = association.GetValue()
+		var revision = revisions.GetValue(name)
+		if revision == nil {
+			v.addChange(RemovedChange, name, "The instance was removed.", true)
+			continue
+		}
+		v.compareDeclarations(
+			name,
+			original.GetDeclaration(),
+			revision.GetDeclaration(),
+		)
+		v.compareMembers(
+			name,
+			"attribute",
+			v.extractAttributes(original.GetAttributes()),
+			v.extractAttributes(revision.GetAttributes()),
+		)
+		v.compareValues(
+			name,
+			"default value",
+			v.extractDefaultValues(original.GetAttributes()),
+			v.extractDefaultValues(revision.GetAttributes()),
+		)
+		v.compareAbstractions(
+			name,
+			v.extractAbstractions(original.GetAbstractions()),
+			v.extractAbstractions(revision.GetAbstractions()),
+		)
+		v.compareMembers(
+			name,
+			"method",
+			v.extractMethods(original.GetMethods()),
+			v.extractMethods(revision.GetMethods()),
+		)
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if originals.GetValue(name) == nil {
+			v.addChange(AddedChange, name, "The instance was added.", false)
+		}
+	}
+}
+
+func (v *comparator_) compareMembers(
+	path string,
+	kind string,
+	originals col.CatalogLike[string, MethodLike],
+	revisions col.CatalogLike[string, MethodLike],
+) {
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var original = association.GetValue()
+		var revision = revisions.GetValue(name)
+		if revision == nil {
+			var description = fmt.Sprintf("The %v was removed.", kind)
+			v.addChange(RemovedChange, path+"."+name, description, true)
+			continue
+		}
+		v.compareSignatures(
+			path+"."+name,
+			original.GetParameters(),
+			original.GetResult(),
+			revision.GetParameters(),
+			revision.GetResult(),
+		)
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if originals.GetValue(name) == nil {
+			// Like apidiff, an interface that gains a member is not satisfied
+			// by any of its existing implementations.
+			var description = fmt.Sprintf("The %v was added.", kind)
+			v.addChange(AddedChange, path+"."+name, description, true)
+		}
+	}
+}
+
+func (v *comparator_) compareSignatures(
+	path string,
+	originalParameters ParametersLike,
+	originalResult ResultLike,
+	revisedParameters ParametersLike,
+	revisedResult ResultLike,
+) {
+	var originalTypes = v.formatParameterTypes(originalParameters)
+	var revisedTypes = v.formatParameterTypes(revisedParameters)
+	var originalText = v.formatParameters(originalParameters)
+	var revisedText = v.formatParameters(revisedParameters)
+	switch {
+	case originalTypes != revisedTypes:
+		var description = fmt.Sprintf(
+			"The parameters changed from (%v) to (%v).",
+			originalText,
+			revisedText,
+		)
+		v.addChange(ModifiedChange, path, description, true)
+	case originalText != revisedText:
+		var description = fmt.Sprintf(
+			"The parameter names changed from (%v) to (%v).",
+			originalText,
+			revisedText,
+		)
+		v.addChange(ModifiedChange, path, description, false)
+	}
+
+	originalTypes = v.formatResultTypes(originalResult)
+	revisedTypes = v.formatResultTypes(revisedResult)
+	originalText = v.formatResult(originalResult)
+	revisedText = v.formatResult(revisedResult)
+	switch {
+	case originalTypes != revisedTypes:
+		var description = fmt.Sprintf(
+			"The result changed from %q to %q.",
+			originalText,
+			revisedText,
+		)
+		v.addChange(ModifiedChange, path, description, true)
+	case originalText != revisedText:
+		var description = fmt.Sprintf(
+			"The result names changed from %q to %q.",
+			originalText,
+			revisedText,
+		)
+		v.addChange(ModifiedChange, path, description, false)
+	}
+}
+
+func (v *comparator_) compareSpecializations(
+	originals col.CatalogLike[string, SpecializationLike],
+	revisions col.CatalogLike[string, SpecializationLike],
+) {
+	var formatter = Formatter().Make()
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var original = association.GetValue()
+		var revision = revisions.GetValue(name)
+		if revision == nil {
+			v.addChange(RemovedChange, name, "The specialized type was removed.", true)
+			continue
+		}
+		v.compareDeclarations(
+			name,
+			original.GetDeclaration(),
+			revision.GetDeclaration(),
+		)
+		var originalType = formatter.FormatAbstraction(original.GetAbstraction())
+		var revisedType = formatter.FormatAbstraction(revision.GetAbstraction())
+		if originalType != revisedType {
+			var description = fmt.Sprintf(
+				"The underlying type changed from %v to %v.",
+				originalType,
+				revisedType,
+			)
+			v.addChange(ModifiedChange, name, description, true)
+		}
+		v.compareEnumerations(
+			name,
+			original.GetEnumeration(),
+			revision.GetEnumeration(),
+		)
+	}
+	iterator = revisions.GetIterator()
+	for iterator.HasNext() {
+		var name = iterator.GetNext().GetKey()
+		if originals.GetValue(name) == nil {
+			v.addChange(AddedChange, name, "The specialized type was added.", false)
+		}
+	}
+}
+
+func (v *comparator_) compareValues(
+	path string,
+	kind string,
+	originals col.CatalogLike[string, string],
+	revisions col.CatalogLike[string, string],
+) {
+	// Only the members that exist in both models are compared.
+	var iterator = originals.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var name = association.GetKey()
+		var originalValue = association.GetValue()
+		var revisedValue = revisions.GetValue(name)
+		if revisedValue != originalValue && v.containsKey(revisions, name) {
+			var description = fmt.Sprintf(
+				"The %v changed from %q to %q.",
+				kind,
+				originalValue,
+				revisedValue,
+			)
+			v.addChange(ModifiedChange, path+"."+name, description, false)
+		}
+	}
+}
+
+func (v *comparator_) containsKey(
+	catalog col.CatalogLike[string, string],
+	key string,
+) bool {
+	var iterator = catalog.GetKeys().GetIterator()
+	for iterator.HasNext() {
+		if iterator.GetNext() == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *comparator_) extractAbstractions(
+	abstractions AbstractionsLike,
+) col.CatalogLike[string, AbstractionLike] {
+	var formatter = Formatter().Make()
+	var catalog = col.Catalog[string, AbstractionLike]().Make()
+	if abstractions == nil {
+		return catalog
+	}
+	var iterator = abstractions.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var abstraction = iterator.GetNext()
+		var name = formatter.FormatAbstraction(abstraction)
+		catalog.SetValue(name, abstraction)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractAspects(
+	model ModelLike,
+) col.CatalogLike[string, AspectLike] {
+	var catalog = col.Catalog[string, AspectLike]().Make()
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil || interfaces.GetAspects() == nil {
+		return catalog
+	}
+	var iterator = interfaces.GetAspects().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var aspect = iterator.GetNext()
+		var name = aspect.GetDeclaration().GetIdentifier()
+		catalog.SetValue(name, aspect)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractAttributes(
+	attributes AttributesLike,
+) col.CatalogLike[string, MethodLike] {
+	var catalog = col.Catalog[string, MethodLike]().Make()
+	if attributes == nil {
+		return catalog
+	}
+	var iterator = attributes.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var attribute = iterator.GetNext()
+		var parameters ParametersLike
+		var parameter = attribute.GetParameter()
+		if parameter != nil {
+			var sequence = col.List[ParameterLike]().Make()
+			sequence.AppendValue(parameter)
+			parameters = Parameters().MakeWithAttributes(sequence)
+		}
+		var result ResultLike
+		var abstraction = attribute.GetAbstraction()
+		if abstraction != nil {
+			result = Result().MakeWithAbstraction(abstraction)
+		}
+		var name = attribute.GetIdentifier()
+		var method = Method().MakeWithAttributes("", name, parameters, result)
+		catalog.SetValue(name, method)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractClasses(
+	model ModelLike,
+) col.CatalogLike[string, ClassLike] {
+	var catalog = col.Catalog[string, ClassLike]().Make()
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil || interfaces.GetClasses() == nil {
+		return catalog
+	}
+	var iterator = interfaces.GetClasses().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var class = iterator.GetNext()
+		var name = class.GetDeclaration().GetIdentifier()
+		catalog.SetValue(name, class)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractConstantValues(
+	constants ConstantsLike,
+) col.CatalogLike[string, string] {
+	var catalog = col.Catalog[string, string]().Make()
+	if constants == nil {
+		return catalog
+	}
+	var iterator = constants.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var constant = iterator.GetNext()
+		catalog.SetValue(constant.GetIdentifier(), constant.GetValue())
+	}
+	return catalog
+}
+
+func (v *comparator_) extractConstants(
+	constants ConstantsLike,
+) col.CatalogLike[string, MethodLike] {
+	var catalog = col.Catalog[string, MethodLike]().Make()
+	if constants == nil {
+		return catalog
+	}
+	var iterator = constants.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var constant = iterator.GetNext()
+		var name = constant.GetIdentifier()
+		var result = Result().MakeWithAbstraction(constant.GetAbstraction())
+		var method = Method().MakeWithAttributes("", name, nil, result)
+		catalog.SetValue(name, method)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractConstructors(
+	constructors ConstructorsLike,
+) col.CatalogLike[string, MethodLike] {
+	var catalog = col.Catalog[string, MethodLike]().Make()
+	if constructors == nil {
+		return catalog
+	}
+	var iterator = constructors.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var constructor = iterator.GetNext()
+		var name = constructor.GetIdentifier()
+		var parameters = constructor.GetParameters()
+		var result = Result().MakeWithAbstraction(constructor.GetAbstraction())
+		var method = Method().MakeWithAttributes("", name, parameters, result)
+		catalog.SetValue(name, method)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractDefaultValues(
+	attributes AttributesLike,
+) col.CatalogLike[string, string] {
+	var catalog = col.Catalog[string, string]().Make()
+	if attributes == nil {
+		return catalog
+	}
+	var iterator = attributes.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var attribute = iterator.GetNext()
+		catalog.SetValue(attribute.GetIdentifier(), attribute.GetDefault())
+	}
+	return catalog
+}
+
+func (v *comparator_) extractFunctionals(
+	model ModelLike,
+) col.CatalogLike[string, FunctionalLike] {
+	var catalog = col.Catalog[string, FunctionalLike]().Make()
+	var types = model.GetTypes()
+	if types == nil || types.GetFunctionals() == nil {
+		return catalog
+	}
+	var iterator = types.GetFunctionals().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var functional = iterator.GetNext()
+		var name = functional.GetDeclaration().GetIdentifier()
+		catalog.SetValue(name, functional)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractFunctions(
+	functions FunctionsLike,
+) col.CatalogLike[string, MethodLike] {
+	var catalog = col.Catalog[string, MethodLike]().Make()
+	if functions == nil {
+		return catalog
+	}
+	var iterator = functions.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var function = iterator.GetNext()
+		var name = function.GetIdentifier()
+		var method = Method().MakeWithAttributes(
+			"",
+			name,
+			function.GetParameters(),
+			function.GetResult(),
+		)
+		catalog.SetValue(name, method)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractInstances(
+	model ModelLike,
+) col.CatalogLike[string, InstanceLike] {
+	var catalog = col.Catalog[string, InstanceLike]().Make()
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil || interfaces.GetInstances() == nil {
+		return catalog
+	}
+	var iterator = interfaces.GetInstances().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var instance = iterator.GetNext()
+		var name = instance.GetDeclaration().GetIdentifier()
+		catalog.SetValue(name, instance)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractMethods(
+	methods MethodsLike,
+) col.CatalogLike[string, MethodLike] {
+	var catalog = col.Catalog[string, MethodLike]().Make()
+	if methods == nil {
+		return catalog
+	}
+	var iterator = methods.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var method = iterator.GetNext()
+		catalog.SetValue(method.GetIdentifier(), method)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractSpecializations(
+	model ModelLike,
+) col.CatalogLike[string, SpecializationLike] {
+	var catalog = col.Catalog[string, SpecializationLike]().Make()
+	var types = model.GetTypes()
+	if types == nil || types.GetSpecializations() == nil {
+		return catalog
+	}
+	var iterator = types.GetSpecializations().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var specialization = iterator.GetNext()
+		var name = specialization.GetDeclaration().GetIdentifier()
+		catalog.SetValue(name, specialization)
+	}
+	return catalog
+}
+
+func (v *comparator_) extractValues(
+	enumeration EnumerationLike,
+) col.CatalogLike[string, int] {
+	// The positions of the enumeration values start at one.
+	var catalog = col.Catalog[string, int]().Make()
+	if enumeration == nil {
+		return catalog
+	}
+	var values = enumeration.GetValues()
+	var position = 1
+	catalog.SetValue(values.GetParameter().GetIdentifier(), position)
+	var iterator = values.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		position++
+		catalog.SetValue(iterator.GetNext(), position)
+	}
+	return catalog
+}
+
+func (v *comparator_) formatParameterTypes(parameters ParametersLike) string {
+	var formatter = Formatter().Make()
+	var types string
+	if parameters == nil {
+		return types
+	}
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		if len(types) > 0 {
+			types += ", "
+		}
+		if parameter.IsVariadic() {
+			types += "..."
+		}
+		types += formatter.FormatAbstraction(parameter.GetAbstraction())
+	}
+	return types
+}
+
+func (v *comparator_) formatParameters(parameters ParametersLike) string {
+	// The parameters are formatted on a single line.
+	var formatter = Formatter().Make()
+	var text string
+	if parameters == nil {
+		return text
+	}
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		if len(text) > 0 {
+			text += ", "
+		}
+		text += formatter.FormatParameter(parameter)
+	}
+	return text
+}
+
+func (v *comparator_) formatResult(result ResultLike) string {
+	var formatter = Formatter().Make()
+	var text string
+	switch {
+	case result == nil:
+	case result.GetAbstraction() != nil:
+		text = formatter.FormatAbstraction(result.GetAbstraction())
+	default:
+		text = "(" + v.formatParameters(result.GetParameters()) + ")"
+	}
+	return text
+}
+
+func (v *comparator_) formatResultTypes(result ResultLike) string {
+	var formatter = Formatter().Make()
+	var types string
+	switch {
+	case result == nil:
+	case result.GetAbstraction() != nil:
+		types = formatter.FormatAbstraction(result.GetAbstraction())
+	default:
+		types = "(" + v.formatParameterTypes(result.GetParameters()) + ")"
+	}
+	return types
+}
@@ -40,10 +40,17 @@ type constantClass_ struct {
 
 // Constructors
 
-func (c *constantClass_) MakeWithAttributes(identifier string, abstraction AbstractionLike) ConstantLike {
+func (c *constantClass_) MakeWithAttributes(
+	comment string,
+	identifier string,
+	abstraction AbstractionLike,
+	value string,
+) ConstantLike {
 	return &constant_{
+		comment_:     comment,
 		identifier_:  identifier,
 		abstraction_: abstraction,
+		value_:       value,
 	}
 }
 
@@ -54,12 +61,18 @@ func (c *constantClass_) MakeWithAttributes(identifier string, abstraction Abstr
 // Target
 
 type constant_ struct {
+	comment_     string
 	identifier_  string
 	abstraction_ AbstractionLike
+	value_       string
 }
 
 // Attributes
 
+func (v *constant_) GetComment() string {
+	return v.comment_
+}
+
 func (v *constant_) GetIdentifier() string {
 	return v.identifier_
 }
@@ -68,6 +81,10 @@ func (v *constant_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
 
+func (v *constant_) GetValue() string {
+	return v.value_
+}
+
 // Public
 
 // Private
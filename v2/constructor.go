@@ -41,11 +41,13 @@ type constructorClass_ struct {
 // Constructors
 
 func (c *constructorClass_) MakeWithAttributes(
+	comment string,
 	identifier string,
 	parameters ParametersLike,
 	abstraction AbstractionLike,
 ) ConstructorLike {
 	return &constructor_{
+		comment_:     comment,
 		identifier_:  identifier,
 		parameters_:  parameters,
 		abstraction_: abstraction,
@@ -59,6 +61,7 @@ func (c *constructorClass_) MakeWithAttributes(
 // Target
 
 type constructor_ struct {
+	comment_     string
 	identifier_  string
 	parameters_  ParametersLike
 	abstraction_ AbstractionLike
@@ -66,6 +69,10 @@ type constructor_ struct {
 
 // Attributes
 
+func (v *constructor_) GetComment() string {
+	return v.comment_
+}
+
 func (v *constructor_) GetIdentifier() string {
 	return v.identifier_
 }
@@ -0,0 +1,107 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	fmt "fmt"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var diagnosticClass = &diagnosticClass_{
+	strings_: map[RuleType]string{
+		ErrorRule:       "error",
+		CommentRule:     "comment",
+		ConstructorRule: "constructor",
+		EnumerationRule: "enumeration",
+		ParameterRule:   "parameter",
+		UsageRule:       "usage",
+	},
+}
+
+// Function
+
+func Diagnostic() DiagnosticClassLike {
+	return diagnosticClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type diagnosticClass_ struct {
+	strings_ map[RuleType]string
+}
+
+// Constructors
+
+func (c *diagnosticClass_) MakeWithAttributes(
+	rule RuleType,
+	path string,
+	description string,
+) DiagnosticLike {
+	return &diagnostic_{
+		rule_:        rule,
+		path_:        path,
+		description_: description,
+	}
+}
+
+// Functions
+
+func (c *diagnosticClass_) AsRule(name string) RuleType {
+	for rule, string_ := range c.strings_ {
+		if rule != ErrorRule && string_ == name {
+			return rule
+		}
+	}
+	var message = fmt.Sprintf(
+		"An unknown lint rule was specified: %v",
+		name,
+	)
+	panic(message)
+}
+
+func (c *diagnosticClass_) AsString(rule RuleType) string {
+	return c.strings_[rule]
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type diagnostic_ struct {
+	rule_        RuleType
+	path_        string // The dot separated path to the offending model element.
+	description_ string
+}
+
+// Attributes
+
+func (v *diagnostic_) GetRule() RuleType {
+	return v.rule_
+}
+
+func (v *diagnostic_) GetPath() string {
+	return v.path_
+}
+
+func (v *diagnostic_) GetDescription() string {
+	return v.description_
+}
+
+// Public
+
+// Private
@@ -0,0 +1,318 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var diagrammerClass = &diagrammerClass_{
+	// This class does not initialize any class constants.
+}
+
+// Function
+
+func Diagrammer() DiagrammerClassLike {
+	return diagrammerClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type diagrammerClass_ struct {
+	// This class does not define any class constants.
+}
+
+// Constructors
+
+func (c *diagrammerClass_) MakeWithFormat(format FormatType) DiagrammerLike {
+	switch format {
+	case DOTFormat, MermaidFormat, PlantUMLFormat:
+	default:
+		var message = fmt.Sprintf(
+			"An unsupported diagram format was specified: %v",
+			format,
+		)
+		panic(message)
+	}
+	return &diagrammer_{
+		format_: format,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type diagrammer_ struct {
+	// The diagrammer only visits the nodes that it needs for the diagram.
+	WalkerLike
+	format_  FormatType
+	modules_ col.CatalogLike[string, string] // Maps module aliases to paths.
+	nodes_   col.CatalogLike[string, string] // Maps nodes to their kinds.
+	edges_   col.CatalogLike[string, string] // Maps edges to their kinds.
+	current_ string                          // The node being visited.
+}
+
+// Attributes
+
+func (v *diagrammer_) GetFormat() FormatType {
+	return v.format_
+}
+
+// Visitor
+
+func (v *diagrammer_) EnterAbstraction(abstraction AbstractionLike) {
+	if len(v.current_) == 0 {
+		return
+	}
+	var identifier = abstraction.GetIdentifier()
+	var prefix = abstraction.GetPrefix()
+	if prefix != nil && prefix.GetType() == AliasPrefix {
+		var alias = prefix.GetIdentifier()
+		var path = v.modules_.GetValue(alias)
+		if len(path) > 0 {
+			v.nodes_.SetValue(alias, "module")
+			v.addEdge(v.current_, alias, "imports")
+		}
+		return
+	}
+	if len(v.nodes_.GetValue(identifier)) > 0 && identifier != v.current_ {
+		v.addEdge(v.current_, identifier, "uses")
+	}
+}
+
+func (v *diagrammer_) EnterAspect(aspect AspectLike) {
+	v.current_ = aspect.GetDeclaration().GetIdentifier()
+}
+
+func (v *diagrammer_) EnterClass(class ClassLike) {
+	v.current_ = class.GetDeclaration().GetIdentifier()
+}
+
+func (v *diagrammer_) EnterInstance(instance InstanceLike) {
+	v.current_ = instance.GetDeclaration().GetIdentifier()
+	var abstractions = instance.GetAbstractions()
+	if abstractions == nil {
+		return
+	}
+	var iterator = abstractions.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var abstraction = iterator.GetNext()
+		var identifier = abstraction.GetIdentifier()
+		var prefix = abstraction.GetPrefix()
+		if prefix != nil && prefix.GetType() == AliasPrefix {
+			identifier = prefix.GetIdentifier() + "." + identifier
+			v.nodes_.SetValue(identifier, "aspect")
+		}
+		v.addEdge(v.current_, identifier, "implements")
+	}
+}
+
+func (v *diagrammer_) LeaveAspect(aspect AspectLike) {
+	v.current_ = ""
+}
+
+func (v *diagrammer_) LeaveClass(class ClassLike) {
+	v.current_ = ""
+}
+
+func (v *diagrammer_) LeaveInstance(instance InstanceLike) {
+	v.current_ = ""
+}
+
+// Public
+
+func (v *diagrammer_) DiagramModel(model ModelLike) string {
+	v.WalkerLike = Walker().Make()
+	v.modules_ = col.Catalog[string, string]().Make()
+	v.nodes_ = col.Catalog[string, string]().Make()
+	v.edges_ = col.Catalog[string, string]().Make()
+	v.extractModules(model)
+	v.extractNodes(model)
+	v.Walk(model, v)
+	var name = model.GetHeader().GetIdentifier()
+	var diagram string
+	switch v.format_ {
+	case DOTFormat:
+		diagram = v.formatDOT(name)
+	case MermaidFormat:
+		diagram = v.formatMermaid(name)
+	case PlantUMLFormat:
+		diagram = v.formatPlantUML(name)
+	}
+	return diagram
+}
+
+// Private
+
+func (v *diagrammer_) addEdge(from string, to string, kind string) {
+	var edge = from + " " + to
+	if len(v.edges_.GetValue(edge)) > 0 {
+		// Keep the most specific kind of an existing edge.
+		return
+	}
+	v.edges_.SetValue(edge, kind)
+}
+
+func (v *diagrammer_) extractModules(model ModelLike) {
+	var imports = model.GetImports()
+	if imports == nil || imports.GetModules() == nil {
+		return
+	}
+	var iterator = imports.GetModules().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var module = iterator.GetNext()
+		var path = sts.Trim(module.GetText(), `"`)
+		v.modules_.SetValue(module.GetIdentifier(), path)
+	}
+}
+
+func (v *diagrammer_) extractNodes(model ModelLike) {
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil {
+		return
+	}
+	var aspects = interfaces.GetAspects()
+	if aspects != nil {
+		var iterator = aspects.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var identifier = iterator.GetNext().GetDeclaration().GetIdentifier()
+			v.nodes_.SetValue(identifier, "aspect")
+		}
+	}
+	var classes = interfaces.GetClasses()
+	if classes != nil {
+		var iterator = classes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var identifier = iterator.GetNext().GetDeclaration().GetIdentifier()
+			v.nodes_.SetValue(identifier, "class")
+		}
+	}
+	var instances = interfaces.GetInstances()
+	if instances != nil {
+		var iterator = instances.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var identifier = iterator.GetNext().GetDeclaration().GetIdentifier()
+			v.nodes_.SetValue(identifier, "instance")
+		}
+	}
+}
+
+func (v *diagrammer_) formatDOT(name string) string {
+	var diagram = fmt.Sprintf("digraph %q {\n", name)
+	diagram += "\tnode [shape=box];\n"
+	var nodes = v.nodes_.GetIterator()
+	for nodes.HasNext() {
+		var node = nodes.GetNext()
+		var identifier = node.GetKey()
+		var kind = node.GetValue()
+		var shape = "box"
+		var label = identifier + "\\n«" + kind + "»"
+		if kind == "module" {
+			shape = "folder"
+			label = identifier + "\\n" + v.modules_.GetValue(identifier)
+		}
+		diagram += fmt.Sprintf("\t%q [shape=%v, label=\"%v\"];\n", identifier, shape, label)
+	}
+	var edges = v.edges_.GetIterator()
+	for edges.HasNext() {
+		var edge = edges.GetNext()
+		var ends = sts.Split(edge.GetKey(), " ")
+		var style = "style=dashed"
+		if edge.GetValue() == "implements" {
+			style += ", arrowhead=empty"
+		}
+		diagram += fmt.Sprintf("\t%q -> %q [%v, label=%q];\n", ends[0], ends[1], style, edge.GetValue())
+	}
+	diagram += "}\n"
+	return diagram
+}
+
+func (v *diagrammer_) formatMermaid(name string) string {
+	var diagram = "---\ntitle: " + name + "\n---\nclassDiagram\n"
+	var nodes = v.nodes_.GetIterator()
+	for nodes.HasNext() {
+		var node = nodes.GetNext()
+		var identifier = v.makeIdentifier(node.GetKey())
+		diagram += fmt.Sprintf("\tclass %v {\n\t\t<<%v>>\n\t}\n", identifier, node.GetValue())
+	}
+	var edges = v.edges_.GetIterator()
+	for edges.HasNext() {
+		var edge = edges.GetNext()
+		var ends = sts.Split(edge.GetKey(), " ")
+		var arrow = "..>"
+		if edge.GetValue() == "implements" {
+			arrow = "..|>"
+		}
+		diagram += fmt.Sprintf(
+			"\t%v %v %v : %v\n",
+			v.makeIdentifier(ends[0]),
+			arrow,
+			v.makeIdentifier(ends[1]),
+			edge.GetValue(),
+		)
+	}
+	return diagram
+}
+
+func (v *diagrammer_) formatPlantUML(name string) string {
+	var diagram = "@startuml " + name + "\n"
+	var nodes = v.nodes_.GetIterator()
+	for nodes.HasNext() {
+		var node = nodes.GetNext()
+		var identifier = node.GetKey()
+		var kind = node.GetValue()
+		if kind == "module" {
+			var path = v.modules_.GetValue(identifier)
+			diagram += fmt.Sprintf("package \"%v\" as %v {\n}\n", path, identifier)
+			continue
+		}
+		diagram += fmt.Sprintf(
+			"interface \"%v\" as %v <<%v>>\n",
+			identifier,
+			v.makeIdentifier(identifier),
+			kind,
+		)
+	}
+	var edges = v.edges_.GetIterator()
+	for edges.HasNext() {
+		var edge = edges.GetNext()
+		var ends = sts.Split(edge.GetKey(), " ")
+		var arrow = "..>"
+		if edge.GetValue() == "implements" {
+			arrow = "..|>"
+		}
+		diagram += fmt.Sprintf(
+			"%v %v %v : %v\n",
+			v.makeIdentifier(ends[0]),
+			arrow,
+			v.makeIdentifier(ends[1]),
+			edge.GetValue(),
+		)
+	}
+	diagram += "@enduml\n"
+	return diagram
+}
+
+func (v *diagrammer_) makeIdentifier(name string) string {
+	// Qualified aspect names are not valid diagram identifiers.
+	return sts.ReplaceAll(name, ".", "_")
+}
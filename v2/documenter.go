@@ -0,0 +1,724 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3"
+	htm "html"
+	osx "os"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var documenterClass = &documenterClass_{
+	// This class does not initialize any class constants.
+}
+
+// Function
+
+func Documenter() DocumenterClassLike {
+	return documenterClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type documenterClass_ struct {
+	// This class does not define any class constants.
+}
+
+// Constructors
+
+func (c *documenterClass_) MakeWithFormat(format FormatType) DocumenterLike {
+	switch format {
+	case HTMLFormat, MarkdownFormat:
+	default:
+		var message = fmt.Sprintf(
+			"An unsupported document format was specified: %v",
+			format,
+		)
+		panic(message)
+	}
+	return &documenter_{
+		format_: format,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type documenter_ struct {
+	format_ FormatType
+	model_  ModelLike // Only set on the documenter of a single call.
+	result_ sts.Builder
+}
+
+// Attributes
+
+func (v *documenter_) GetFormat() FormatType {
+	return v.format_
+}
+
+// Public
+
+func (v *documenter_) DocumentModel(model ModelLike) col.CatalogLike[string, string] {
+	// A separate documenter is used so that this documenter may document
+	// concurrently.
+	var documenter = &documenter_{
+		format_: v.format_,
+		model_:  model,
+	}
+	return documenter.documentModel()
+}
+
+func (v *documenter_) DocumentPackage(directory string) {
+	if !sts.HasSuffix(directory, "/") {
+		directory += "/"
+	}
+	var modelFile = directory + "Package.go"
+	var bytes, err = osx.ReadFile(modelFile)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The specified directory is missing a model file: %v",
+			modelFile,
+		)
+		panic(message)
+	}
+	var model = Parser().Make().ParseSource(string(bytes))
+	Validator().Make().ValidateModel(model)
+
+	// The documentation pages are always regenerated.
+	var documentation = directory + "docs/"
+	err = osx.MkdirAll(documentation, 0755)
+	if err != nil {
+		panic(err)
+	}
+	var iterator = v.DocumentModel(model).GetIterator()
+	for iterator.HasNext() {
+		var page = iterator.GetNext()
+		var pageFile = documentation + page.GetKey()
+		err = osx.WriteFile(pageFile, []byte(page.GetValue()), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Private
+
+func (v *documenter_) appendCode(code string) {
+	switch v.format_ {
+	case HTMLFormat:
+		v.result_.WriteString("<pre><code>" + htm.EscapeString(code) + "</code></pre>\n")
+	default:
+		v.result_.WriteString("```go\n" + code + "\n```\n\n")
+	}
+}
+
+func (v *documenter_) appendComment(comment string) {
+	var text = v.extractText(comment)
+	if len(text) == 0 {
+		return
+	}
+	var paragraphs = sts.Split(text, "\n\n")
+	for _, paragraph := range paragraphs {
+		switch {
+		case v.format_ == MarkdownFormat && sts.HasPrefix(paragraph, "\t"):
+			// An indented paragraph is already a code block in Markdown.
+			v.result_.WriteString(paragraph + "\n\n")
+		case v.format_ == MarkdownFormat:
+			v.result_.WriteString(v.escapeMarkdown(paragraph) + "\n\n")
+		case sts.HasPrefix(paragraph, "\t"):
+			// An indented paragraph is an example that must be preformatted.
+			v.appendCode(v.dedent(paragraph))
+		default:
+			v.result_.WriteString("<p>" + htm.EscapeString(paragraph) + "</p>\n")
+		}
+	}
+}
+
+func (v *documenter_) appendHeading(level int, text string, anchor string) {
+	switch v.format_ {
+	case HTMLFormat:
+		var id string
+		if len(anchor) > 0 {
+			id = fmt.Sprintf(" id=%q", sts.ToLower(anchor))
+		}
+		v.result_.WriteString(fmt.Sprintf("<h%v%v>%v</h%v>\n", level, id, text, level))
+	default:
+		v.result_.WriteString(sts.Repeat("#", level) + " " + text + "\n\n")
+	}
+}
+
+func (v *documenter_) appendItem(item string) {
+	switch v.format_ {
+	case HTMLFormat:
+		v.result_.WriteString("<li>" + item + "</li>\n")
+	default:
+		v.result_.WriteString("- " + item + "\n")
+	}
+}
+
+func (v *documenter_) appendItems(items col.Sequential[string]) {
+	if items.IsEmpty() {
+		return
+	}
+	if v.format_ == HTMLFormat {
+		v.result_.WriteString("<ul>\n")
+	}
+	var iterator = items.GetIterator()
+	for iterator.HasNext() {
+		v.appendItem(iterator.GetNext())
+	}
+	if v.format_ == HTMLFormat {
+		v.result_.WriteString("</ul>\n")
+	} else {
+		v.result_.WriteString("\n")
+	}
+}
+
+func (v *documenter_) appendMember(
+	signature string,
+	comment string,
+	label string,
+	value string,
+) {
+	v.appendHeading(4, v.formatCode(signature), "")
+	v.appendComment(comment)
+	if len(value) > 0 {
+		v.appendParagraph(label + ": " + v.formatCode(value))
+	}
+}
+
+func (v *documenter_) appendParagraph(text string) {
+	switch v.format_ {
+	case HTMLFormat:
+		v.result_.WriteString("<p>" + text + "</p>\n")
+	default:
+		v.result_.WriteString(text + "\n\n")
+	}
+}
+
+func (v *documenter_) appendTable(headings []string, rows [][]string) {
+	switch v.format_ {
+	case HTMLFormat:
+		v.result_.WriteString("<table>\n<tr>")
+		for _, heading := range headings {
+			v.result_.WriteString("<th>" + heading + "</th>")
+		}
+		v.result_.WriteString("</tr>\n")
+		for _, row := range rows {
+			v.result_.WriteString("<tr>")
+			for _, cell := range row {
+				v.result_.WriteString("<td>" + cell + "</td>")
+			}
+			v.result_.WriteString("</tr>\n")
+		}
+		v.result_.WriteString("</table>\n")
+	default:
+		v.result_.WriteString("| " + sts.Join(headings, " | ") + " |\n")
+		v.result_.WriteString("|" + sts.Repeat(" --- |", len(headings)) + "\n")
+		for _, row := range rows {
+			v.result_.WriteString("| " + sts.Join(row, " | ") + " |\n")
+		}
+		v.result_.WriteString("\n")
+	}
+}
+
+func (v *documenter_) beginPage(title string) {
+	v.result_.Reset()
+	if v.format_ == HTMLFormat {
+		v.result_.WriteString("<!DOCTYPE html>\n<html>\n<head>\n")
+		v.result_.WriteString("<meta charset=\"utf-8\">\n")
+		v.result_.WriteString("<title>" + htm.EscapeString(title) + "</title>\n")
+		v.result_.WriteString("</head>\n<body>\n")
+	}
+	v.appendHeading(1, htm.EscapeString(title), "")
+}
+
+func (v *documenter_) dedent(text string) string {
+	var lines = sts.Split(text, "\n")
+	for index, line := range lines {
+		lines[index] = sts.TrimPrefix(line, "\t")
+	}
+	return sts.Join(lines, "\n")
+}
+
+func (v *documenter_) documentAbstraction(abstraction AbstractionLike) {
+	var formatter = Formatter().Make()
+	var name = formatter.FormatAbstraction(abstraction)
+	if abstraction.GetPrefix() != nil {
+		// We only know the method signatures for the local aspects.
+		v.appendHeading(4, v.formatCode(name), "")
+		return
+	}
+	var identifier = abstraction.GetIdentifier()
+	var page = v.makeFilename("index")
+	var anchor = identifier
+	var class = v.retrieveClass(identifier)
+	if class != nil {
+		// Embedded class interfaces are documented on their own pages.
+		page = v.makeFilename(sts.ToLower(v.extractClassName(class)))
+		anchor = ""
+	}
+	var instance = v.retrieveInstance(identifier)
+	if instance != nil {
+		// Embedded instance interfaces are documented on their own pages.
+		page = v.makeFilename(sts.ToLower(v.extractInstanceName(instance)))
+		anchor = ""
+	}
+	var link = v.formatLink(v.formatCode(name), page, anchor)
+	v.appendHeading(4, link, "")
+	var aspect = v.retrieveAspect(identifier)
+	if aspect == nil || aspect.GetMethods() == nil {
+		return
+	}
+
+	// Expand the aspect methods inline using the actual types.
+	var genericTypes = aspect.GetDeclaration().GetParameters()
+	var concreteTypes = abstraction.GetArguments()
+	var substitutor = Substitutor().MakeWithTypes(genericTypes, concreteTypes)
+	var items = col.List[string]().Make()
+	var iterator = aspect.GetMethods().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var method = substitutor.SubstituteMethod(iterator.GetNext())
+		var signature = v.formatMethod(method)
+		items.AppendValue(v.formatCode(signature))
+	}
+	v.appendItems(items)
+}
+
+func (v *documenter_) documentAspects(aspects AspectsLike) {
+	v.appendHeading(2, "Aspects", "")
+	var iterator = aspects.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var aspect = iterator.GetNext()
+		var declaration = aspect.GetDeclaration()
+		var identifier = declaration.GetIdentifier()
+		v.appendHeading(3, identifier, identifier)
+		v.appendCode(v.formatDeclaration(declaration) + " interface")
+		v.appendComment(declaration.GetComment())
+		var methods = aspect.GetMethods()
+		if methods == nil {
+			continue
+		}
+		var methodIterator = methods.GetSequence().GetIterator()
+		for methodIterator.HasNext() {
+			var method = methodIterator.GetNext()
+			var signature = v.formatMethod(method)
+			v.appendMember(signature, method.GetComment(), "", "")
+		}
+	}
+}
+
+func (v *documenter_) documentClass(class ClassLike) string {
+	var formatter = Formatter().Make()
+	var className = v.extractClassName(class)
+	v.beginPage(className)
+	var index = v.formatLink("Package "+v.model_.GetHeader().GetIdentifier(), v.makeFilename("index"), "")
+	v.appendParagraph(index)
+
+	// Document the class interface.
+	var declaration = class.GetDeclaration()
+	v.appendHeading(2, "Class Interface", "")
+	v.appendCode(v.formatDeclaration(declaration) + " interface")
+	v.appendComment(declaration.GetComment())
+	var constants = class.GetConstants()
+	if constants != nil {
+		v.appendHeading(3, "Constants", "")
+		var iterator = constants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			var signature = constant.GetIdentifier() + "() " +
+				formatter.FormatAbstraction(constant.GetAbstraction())
+			v.appendMember(signature, constant.GetComment(), "Value", constant.GetValue())
+		}
+	}
+	var constructors = class.GetConstructors()
+	if constructors != nil {
+		v.appendHeading(3, "Constructors", "")
+		var iterator = constructors.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constructor = iterator.GetNext()
+			var signature = constructor.GetIdentifier() + "(" +
+				v.formatParameters(constructor.GetParameters()) + ") " +
+				formatter.FormatAbstraction(constructor.GetAbstraction())
+			v.appendMember(signature, constructor.GetComment(), "", "")
+		}
+	}
+	var functions = class.GetFunctions()
+	if functions != nil {
+		v.appendHeading(3, "Functions", "")
+		var iterator = functions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var function = iterator.GetNext()
+			var signature = function.GetIdentifier() + "(" +
+				v.formatParameters(function.GetParameters()) + ") " +
+				v.formatResult(function.GetResult())
+			v.appendMember(signature, function.GetComment(), "", "")
+		}
+	}
+
+	// Document the paired instance interface.
+	var instance = v.retrieveInstance(className + "Like")
+	if instance != nil {
+		v.documentInstance(instance)
+	}
+	return v.endPage()
+}
+
+func (v *documenter_) documentFunctionals(functionals FunctionalsLike) {
+	v.appendHeading(2, "Functionals", "")
+	var iterator = functionals.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var functional = iterator.GetNext()
+		var declaration = functional.GetDeclaration()
+		var identifier = declaration.GetIdentifier()
+		v.appendHeading(3, identifier, sts.ToLower(identifier))
+		var signature = v.formatDeclaration(declaration) + " func(" +
+			v.formatParameters(functional.GetParameters()) + ") " +
+			v.formatResult(functional.GetResult())
+		v.appendCode(signature)
+		v.appendComment(declaration.GetComment())
+	}
+}
+
+func (v *documenter_) documentIndex() string {
+	var header = v.model_.GetHeader()
+	v.beginPage("Package " + header.GetIdentifier())
+	v.appendComment(header.GetComment())
+	var types = v.model_.GetTypes()
+	if types != nil {
+		var specializations = types.GetSpecializations()
+		if specializations != nil {
+			v.documentSpecializations(specializations)
+		}
+		var functionals = types.GetFunctionals()
+		if functionals != nil {
+			v.documentFunctionals(functionals)
+		}
+	}
+	var interfaces = v.model_.GetInterfaces()
+	if interfaces != nil {
+		var aspects = interfaces.GetAspects()
+		if aspects != nil {
+			v.documentAspects(aspects)
+		}
+		var classes = interfaces.GetClasses()
+		if classes != nil {
+			v.appendHeading(2, "Classes", "")
+			var items = col.List[string]().Make()
+			var iterator = classes.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var className = v.extractClassName(iterator.GetNext())
+				var filename = v.makeFilename(sts.ToLower(className))
+				items.AppendValue(v.formatLink(className, filename, ""))
+			}
+			v.appendItems(items)
+		}
+		var instances = interfaces.GetInstances()
+		if instances != nil {
+			var items = col.List[string]().Make()
+			var iterator = instances.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var instanceName = v.extractInstanceName(iterator.GetNext())
+				if v.retrieveClass(instanceName+"ClassLike") != nil {
+					continue
+				}
+				var filename = v.makeFilename(sts.ToLower(instanceName))
+				items.AppendValue(v.formatLink(instanceName, filename, ""))
+			}
+			if !items.IsEmpty() {
+				v.appendHeading(2, "Standalone Instances", "")
+				v.appendItems(items)
+			}
+		}
+	}
+	return v.endPage()
+}
+
+func (v *documenter_) documentInstance(instance InstanceLike) {
+	var formatter = Formatter().Make()
+	var declaration = instance.GetDeclaration()
+	v.appendHeading(2, "Instance Interface", "")
+	v.appendCode(v.formatDeclaration(declaration) + " interface")
+	v.appendComment(declaration.GetComment())
+	var attributes = instance.GetAttributes()
+	if attributes != nil {
+		v.appendHeading(3, "Attributes", "")
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			var signature = attribute.GetIdentifier() + "("
+			var parameter = attribute.GetParameter()
+			if parameter != nil {
+				signature += formatter.FormatParameter(parameter)
+			}
+			signature += ")"
+			var abstraction = attribute.GetAbstraction()
+			if abstraction != nil {
+				signature += " " + formatter.FormatAbstraction(abstraction)
+			}
+			v.appendMember(signature, attribute.GetComment(), "Default", attribute.GetDefault())
+		}
+	}
+	var abstractions = instance.GetAbstractions()
+	if abstractions != nil {
+		v.appendHeading(3, "Abstractions", "")
+		var iterator = abstractions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			v.documentAbstraction(iterator.GetNext())
+		}
+	}
+	var methods = instance.GetMethods()
+	if methods != nil {
+		v.appendHeading(3, "Methods", "")
+		var iterator = methods.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var method = iterator.GetNext()
+			var signature = v.formatMethod(method)
+			v.appendMember(signature, method.GetComment(), "", "")
+		}
+	}
+}
+
+func (v *documenter_) documentModel() col.CatalogLike[string, string] {
+	var pages = col.Catalog[string, string]().Make()
+	pages.SetValue(v.makeFilename("index"), v.documentIndex())
+	var interfaces = v.model_.GetInterfaces()
+	if interfaces != nil && interfaces.GetClasses() != nil {
+		var iterator = interfaces.GetClasses().GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var class = iterator.GetNext()
+			var className = v.extractClassName(class)
+			var filename = v.makeFilename(sts.ToLower(className))
+			pages.SetValue(filename, v.documentClass(class))
+		}
+	}
+	if interfaces != nil && interfaces.GetInstances() != nil {
+		var iterator = interfaces.GetInstances().GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var instance = iterator.GetNext()
+			var instanceName = v.extractInstanceName(instance)
+			if v.retrieveClass(instanceName+"ClassLike") != nil {
+				// Paired instance interfaces are documented with their class.
+				continue
+			}
+			var filename = v.makeFilename(sts.ToLower(instanceName))
+			pages.SetValue(filename, v.documentStandalone(instance))
+		}
+	}
+	return pages
+}
+
+func (v *documenter_) documentStandalone(instance InstanceLike) string {
+	var instanceName = v.extractInstanceName(instance)
+	v.beginPage(instanceName)
+	var index = v.formatLink("Package "+v.model_.GetHeader().GetIdentifier(), v.makeFilename("index"), "")
+	v.appendParagraph(index)
+	v.documentInstance(instance)
+	return v.endPage()
+}
+
+func (v *documenter_) documentSpecializations(specializations SpecializationsLike) {
+	var formatter = Formatter().Make()
+	v.appendHeading(2, "Specializations", "")
+	var iterator = specializations.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var specialization = iterator.GetNext()
+		var declaration = specialization.GetDeclaration()
+		var identifier = declaration.GetIdentifier()
+		v.appendHeading(3, identifier, sts.ToLower(identifier))
+		var abstraction = formatter.FormatAbstraction(specialization.GetAbstraction())
+		v.appendCode(v.formatDeclaration(declaration) + " " + abstraction)
+		v.appendComment(declaration.GetComment())
+		var enumeration = specialization.GetEnumeration()
+		if enumeration == nil {
+			continue
+		}
+		var values = enumeration.GetValues()
+		var rows = [][]string{
+			{v.formatCode(values.GetParameter().GetIdentifier()), "0"},
+		}
+		var valueIterator = values.GetSequence().GetIterator()
+		for valueIterator.HasNext() {
+			var value = valueIterator.GetNext()
+			var ordinal = fmt.Sprint(len(rows))
+			rows = append(rows, []string{v.formatCode(value), ordinal})
+		}
+		v.appendTable([]string{"Value", "Ordinal"}, rows)
+	}
+}
+
+func (v *documenter_) endPage() string {
+	if v.format_ == HTMLFormat {
+		v.result_.WriteString("</body>\n</html>\n")
+	}
+	var page = v.result_.String()
+	v.result_.Reset()
+	return page
+}
+
+func (v *documenter_) escapeMarkdown(text string) string {
+	// Markdown passes raw HTML through, so the markup characters in comment
+	// text must be escaped.
+	var replacer = sts.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	return replacer.Replace(text)
+}
+
+func (v *documenter_) extractClassName(class ClassLike) string {
+	var identifier = class.GetDeclaration().GetIdentifier()
+	return sts.TrimSuffix(identifier, "ClassLike")
+}
+
+func (v *documenter_) extractInstanceName(instance InstanceLike) string {
+	var identifier = instance.GetDeclaration().GetIdentifier()
+	return sts.TrimSuffix(identifier, "Like")
+}
+
+func (v *documenter_) extractText(comment string) string {
+	var lines = sts.Split(sts.TrimSpace(comment), "\n")
+	if len(lines) < 2 {
+		return ""
+	}
+	// Remove the comment delimiters.
+	lines = lines[1 : len(lines)-1]
+	return sts.TrimSpace(sts.Join(lines, "\n"))
+}
+
+func (v *documenter_) formatCode(code string) string {
+	switch v.format_ {
+	case HTMLFormat:
+		return "<code>" + htm.EscapeString(code) + "</code>"
+	default:
+		return "`" + code + "`"
+	}
+}
+
+func (v *documenter_) formatDeclaration(declaration DeclarationLike) string {
+	var text = "type " + declaration.GetIdentifier()
+	var parameters = declaration.GetParameters()
+	if parameters != nil {
+		text += "[" + v.formatParameters(parameters) + "]"
+	}
+	return text
+}
+
+func (v *documenter_) formatLink(text string, page string, anchor string) string {
+	var target = page
+	if len(anchor) > 0 {
+		target += "#" + sts.ToLower(anchor)
+	}
+	switch v.format_ {
+	case HTMLFormat:
+		return fmt.Sprintf("<a href=%q>%v</a>", target, text)
+	default:
+		return "[" + text + "](" + target + ")"
+	}
+}
+
+func (v *documenter_) formatMethod(method MethodLike) string {
+	var signature = method.GetIdentifier() + "("
+	signature += v.formatParameters(method.GetParameters()) + ")"
+	var result = method.GetResult()
+	if result != nil {
+		signature += " " + v.formatResult(result)
+	}
+	return signature
+}
+
+func (v *documenter_) formatParameters(parameters ParametersLike) string {
+	// The parameters are formatted on a single line.
+	var formatter = Formatter().Make()
+	var text string
+	if parameters == nil {
+		return text
+	}
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		if len(text) > 0 {
+			text += ", "
+		}
+		text += formatter.FormatParameter(iterator.GetNext())
+	}
+	return text
+}
+
+func (v *documenter_) formatResult(result ResultLike) string {
+	var abstraction = result.GetAbstraction()
+	if abstraction != nil {
+		var formatter = Formatter().Make()
+		return formatter.FormatAbstraction(abstraction)
+	}
+	return "(" + v.formatParameters(result.GetParameters()) + ")"
+}
+
+func (v *documenter_) makeFilename(name string) string {
+	switch v.format_ {
+	case HTMLFormat:
+		return name + ".html"
+	default:
+		return name + ".md"
+	}
+}
+
+func (v *documenter_) retrieveAspect(identifier string) AspectLike {
+	var interfaces = v.model_.GetInterfaces()
+	if interfaces == nil || interfaces.GetAspects() == nil {
+		return nil
+	}
+	var iterator = interfaces.GetAspects().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var aspect = iterator.GetNext()
+		if aspect.GetDeclaration().GetIdentifier() == identifier {
+			return aspect
+		}
+	}
+	return nil
+}
+
+func (v *documenter_) retrieveClass(identifier string) ClassLike {
+	var interfaces = v.model_.GetInterfaces()
+	if interfaces == nil || interfaces.GetClasses() == nil {
+		return nil
+	}
+	var iterator = interfaces.GetClasses().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var class = iterator.GetNext()
+		if class.GetDeclaration().GetIdentifier() == identifier {
+			return class
+		}
+	}
+	return nil
+}
+
+func (v *documenter_) retrieveInstance(identifier string) InstanceLike {
+	var interfaces = v.model_.GetInterfaces()
+	if interfaces == nil || interfaces.GetInstances() == nil {
+		return nil
+	}
+	var iterator = interfaces.GetInstances().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var instance = iterator.GetNext()
+		if instance.GetDeclaration().GetIdentifier() == identifier {
+			return instance
+		}
+	}
+	return nil
+}
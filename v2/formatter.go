@@ -197,6 +197,10 @@ func (v *formatter_) formatAspects(aspects AspectsLike) {
 }
 
 func (v *formatter_) formatAttribute(attribute AttributeLike) {
+	var comment = attribute.GetComment()
+	if len(comment) > 0 {
+		v.formatComment(comment)
+	}
 	var identifier = attribute.GetIdentifier()
 	v.appendString(identifier)
 	v.appendString("(")
@@ -210,6 +214,11 @@ func (v *formatter_) formatAttribute(attribute AttributeLike) {
 		v.appendString(" ")
 		v.formatAbstraction(abstraction)
 	}
+	var default_ = attribute.GetDefault()
+	if len(default_) > 0 {
+		v.appendString(" // = ")
+		v.appendString(default_)
+	}
 }
 
 func (v *formatter_) formatAttributes(attributes AttributesLike) {
@@ -266,12 +275,40 @@ func (v *formatter_) formatClasses(classes ClassesLike) {
 	}
 }
 
+func (v *formatter_) formatComment(comment string) {
+	// Indent the comment the same way that gofmt does, with the body of the
+	// comment one level deeper than its delimiters.
+	var indentation = sts.Repeat("\t", v.depth_)
+	var lines = sts.Split(sts.TrimRight(comment, "\n"), "\n")
+	v.appendString(lines[0])
+	for _, line := range lines[1:] {
+		v.appendString("\n")
+		switch {
+		case line == "*/":
+			v.appendString(indentation)
+		case len(line) > 0:
+			v.appendString(indentation + "\t")
+		}
+		v.appendString(line)
+	}
+	v.appendNewline()
+}
+
 func (v *formatter_) formatConstant(constant ConstantLike) {
+	var comment = constant.GetComment()
+	if len(comment) > 0 {
+		v.formatComment(comment)
+	}
 	var identifier = constant.GetIdentifier()
 	v.appendString(identifier)
 	v.appendString("() ")
 	var abstraction = constant.GetAbstraction()
 	v.formatAbstraction(abstraction)
+	var value = constant.GetValue()
+	if len(value) > 0 {
+		v.appendString(" // = ")
+		v.appendString(value)
+	}
 }
 
 func (v *formatter_) formatConstants(constants ConstantsLike) {
@@ -286,6 +323,10 @@ func (v *formatter_) formatConstants(constants ConstantsLike) {
 }
 
 func (v *formatter_) formatConstructor(constructor ConstructorLike) {
+	var comment = constructor.GetComment()
+	if len(comment) > 0 {
+		v.formatComment(comment)
+	}
 	var identifier = constructor.GetIdentifier()
 	v.appendString(identifier)
 	v.appendString("(")
@@ -338,6 +379,10 @@ func (v *formatter_) formatEnumeration(enumeration EnumerationLike) {
 }
 
 func (v *formatter_) formatFunction(function FunctionLike) {
+	var comment = function.GetComment()
+	if len(comment) > 0 {
+		v.formatComment(comment)
+	}
 	var identifier = function.GetIdentifier()
 	v.appendString(identifier)
 	v.appendString("(")
@@ -468,6 +513,10 @@ func (v *formatter_) formatInterfaces(interfaces InterfacesLike) {
 }
 
 func (v *formatter_) formatMethod(method MethodLike) {
+	var comment = method.GetComment()
+	if len(comment) > 0 {
+		v.formatComment(comment)
+	}
 	var identifier = method.GetIdentifier()
 	v.appendString(identifier)
 	v.appendString("(")
@@ -548,6 +597,9 @@ func (v *formatter_) formatParameter(parameter ParameterLike) {
 	var identifier = parameter.GetIdentifier()
 	v.appendString(identifier)
 	v.appendString(" ")
+	if parameter.IsVariadic() {
+		v.appendString("...")
+	}
 	var abstraction = parameter.GetAbstraction()
 	v.formatAbstraction(abstraction)
 }
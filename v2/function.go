@@ -41,11 +41,13 @@ type functionClass_ struct {
 // Constructors
 
 func (c *functionClass_) MakeWithAttributes(
+	comment string,
 	identifier string,
 	parameters ParametersLike,
 	result ResultLike,
 ) FunctionLike {
 	return &function_{
+		comment_:    comment,
 		identifier_: identifier,
 		parameters_: parameters,
 		result_:     result,
@@ -59,6 +61,7 @@ func (c *functionClass_) MakeWithAttributes(
 // Target
 
 type function_ struct {
+	comment_    string
 	identifier_ string
 	parameters_ ParametersLike
 	result_     ResultLike
@@ -66,6 +69,10 @@ type function_ struct {
 
 // Attributes
 
+func (v *function_) GetComment() string {
+	return v.comment_
+}
+
 func (v *function_) GetIdentifier() string {
 	return v.identifier_
 }
@@ -13,10 +13,24 @@
 package packages
 
 import (
+	sha "crypto/sha256"
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v3"
+	ast "go/ast"
+	gof "go/format"
+	par "go/parser"
+	tok "go/token"
+	typ "go/types"
+	fss "io/fs"
 	osx "os"
+	pfp "path/filepath"
+	ref "reflect"
+	reg "regexp"
+	run "runtime"
+	dbg "runtime/debug"
 	sts "strings"
+	syn "sync"
+	tem "text/template"
 	tim "time"
 	uni "unicode"
 )
@@ -26,7 +40,7 @@ import (
 // Reference
 
 var generatorClass = &generatorClass_{
-	// This class does not initialize any class constants.
+	// The version of the generator is determined the first time it is needed.
 }
 
 // Function
@@ -40,23 +54,90 @@ func Generator() GeneratorClassLike {
 // Target
 
 type generatorClass_ struct {
-	// This class does not define any class constants.
+	once_    syn.Once
+	version_ string
 }
 
 // Constructors
 
 func (c *generatorClass_) Make() GeneratorLike {
 	return &generator_{
-		// This class does not initialize any instance attributes.
+		options_: col.Set[OptionType]().Make(),
 	}
 }
 
+func (c *generatorClass_) MakeWithOptions(options ...OptionType) GeneratorLike {
+	return &generator_{
+		options_: col.Set[OptionType]().MakeFromArray(options),
+	}
+}
+
+func (c *generatorClass_) MakeWithTemplates(
+	templates fss.FS,
+	options ...OptionType,
+) GeneratorLike {
+	var functions = tem.FuncMap{
+		// The imports are determined once the whole class file is rendered.
+		"imports": func() string { return "<Imports>" },
+	}
+	var set, err = tem.New("templates").Funcs(functions).ParseFS(templates, "*.tmpl")
+	if err != nil {
+		panic(err)
+	}
+	if set.Lookup("class.tmpl") == nil {
+		panic("The template set must contain a class.tmpl template.")
+	}
+	return &generator_{
+		options_:   col.Set[OptionType]().MakeFromArray(options),
+		templates_: set,
+	}
+}
+
+// Private
+
+func (c *generatorClass_) retrieveVersion() string {
+	c.once_.Do(func() {
+		// A generator that was built from a released module is identified by
+		// its version, any other generator by the hash of its executable.
+		var path = ref.TypeOf(generatorClass_{}).PkgPath()
+		var info, ok = dbg.ReadBuildInfo()
+		if ok {
+			var modules = append([]*dbg.Module{&info.Main}, info.Deps...)
+			for _, module := range modules {
+				if module.Path != path {
+					continue
+				}
+				if module.Replace != nil {
+					module = module.Replace
+				}
+				if len(module.Sum) > 0 {
+					c.version_ = module.Version + " " + module.Sum
+					return
+				}
+			}
+		}
+		var executable, err = osx.Executable()
+		if err != nil {
+			return
+		}
+		var bytes []byte
+		bytes, err = osx.ReadFile(executable)
+		if err != nil {
+			return
+		}
+		c.version_ = fmt.Sprintf("%x", sha.Sum256(bytes))
+	})
+	return c.version_
+}
+
 // INSTANCE METHODS
 
 // Target
 
 type generator_ struct {
-	// This class does not define any instance attributes.
+	importer_  *importer_ // The importer for the package being generated.
+	options_   col.SetLike[OptionType]
+	templates_ *tem.Template // The user supplied templates, if any.
 }
 
 // Public
@@ -108,16 +189,226 @@ func (v *generator_) GeneratePackage(directory string) {
 	if !sts.HasSuffix(directory, "/") {
 		directory += "/"
 	}
-	var model = v.parseModel(directory)
-	if model == nil {
-		return
+
+	// Each package is generated by a separate generator whose importer resolves
+	// the imports of the package relative to the module that contains it.
+	var generator = &generator_{
+		importer_:  importer().MakeWithDirectory(directory),
+		options_:   v.options_,
+		templates_: v.templates_,
 	}
-	v.generateModel(directory, model)
-	v.generateClasses(directory, model)
+	generator.generatePackage(directory)
 }
 
 // Private
 
+func (v *generator_) checkPackage(
+	fileSet *tok.FileSet,
+	directory string,
+	subdirectory string,
+	files col.CatalogLike[string, string],
+) *typ.Package {
+	// The pending files take the place of the files that are on disk.
+	var sources = col.Catalog[string, string]().Make()
+	var entries, _ = osx.ReadDir(directory + subdirectory)
+	for _, entry := range entries {
+		var fileName = subdirectory + entry.Name()
+		if entry.IsDir() ||
+			!sts.HasSuffix(fileName, ".go") ||
+			sts.HasSuffix(fileName, "_test.go") {
+			continue
+		}
+		var bytes, err = osx.ReadFile(directory + fileName)
+		if err != nil {
+			panic(err)
+		}
+		sources.SetValue(fileName, string(bytes))
+	}
+	var iterator = files.GetIterator()
+	for iterator.HasNext() {
+		var file = iterator.GetNext()
+		var fileName = file.GetKey()
+		var fileDirectory = sts.TrimPrefix(pfp.Dir(fileName)+"/", "./")
+		if fileDirectory == subdirectory {
+			sources.SetValue(fileName, file.GetValue())
+		}
+	}
+	if sources.IsEmpty() {
+		return nil
+	}
+	sources.SortValues()
+
+	var packageName string
+	var syntaxes []*ast.File
+	iterator = sources.GetIterator()
+	for iterator.HasNext() {
+		var source = iterator.GetNext()
+		var syntax, err = par.ParseFile(
+			fileSet,
+			directory+source.GetKey(),
+			source.GetValue(),
+			par.ParseComments,
+		)
+		if err != nil {
+			panic(err)
+		}
+		packageName = syntax.Name.Name
+		syntaxes = append(syntaxes, syntax)
+	}
+	var errors []string
+	var configuration = typ.Config{
+		Importer: v.importer_,
+		Error: func(err error) {
+			errors = append(errors, err.Error())
+		},
+	}
+	var package_, _ = configuration.Check(packageName, fileSet, syntaxes, nil)
+	if len(errors) > 0 {
+		var message = fmt.Sprintf(
+			"The generated code does not type check:\n%v\n",
+			sts.Join(errors, "\n"),
+		)
+		panic(message)
+	}
+	return package_
+}
+
+func (v *generator_) checkPackages(
+	directory string,
+	files col.CatalogLike[string, string],
+) {
+	var fileSet = tok.NewFileSet()
+	var package_ = v.checkPackage(fileSet, directory, "", files)
+	if v.options_.ContainsValue(MocksOption) {
+		// The mocks import the pending package rather than the one on disk.
+		var importPath = v.retrieveImportPath(directory)
+		v.importer_.AddPackage(importPath, package_)
+		v.checkPackage(fileSet, directory, "mocks/", files)
+	}
+}
+
+func (v *generator_) convertMethod(model ModelLike, function *typ.Func) MethodLike {
+	var signature = function.Type().(*typ.Signature)
+	var parameters = v.convertParameters(
+		model,
+		signature.Params(),
+		signature.Variadic(),
+		"value",
+	)
+	var result ResultLike
+	var results = signature.Results()
+	switch {
+	case results.Len() == 1 && len(results.At(0).Name()) == 0:
+		var abstraction = v.convertType(model, results.At(0).Type())
+		result = Result().MakeWithAbstraction(abstraction)
+	case results.Len() > 0:
+		var resultParameters = v.convertParameters(model, results, false, "result")
+		result = Result().MakeWithParameters(resultParameters)
+	}
+	return Method().MakeWithAttributes("", function.Name(), parameters, result)
+}
+
+func (v *generator_) convertParameters(
+	model ModelLike,
+	tuple *typ.Tuple,
+	isVariadic bool,
+	defaultName string,
+) ParametersLike {
+	if tuple.Len() == 0 {
+		return nil
+	}
+	var sequence = col.List[ParameterLike]().Make()
+	for index := range tuple.Len() {
+		var variable = tuple.At(index)
+		var name = variable.Name()
+		if len(name) == 0 || name == "_" {
+			// Unnamed parameters are numbered so that they can be forwarded.
+			name = fmt.Sprintf("%v%v", defaultName, index+1)
+		}
+		var type_ = variable.Type()
+		var isLast = index == tuple.Len()-1
+		if isVariadic && isLast {
+			type_ = type_.(*typ.Slice).Elem()
+		}
+		var abstraction = v.convertType(model, type_)
+		var parameter = Parameter().MakeWithAttributes(
+			name,
+			isVariadic && isLast,
+			abstraction,
+		)
+		sequence.AppendValue(parameter)
+	}
+	return Parameters().MakeWithAttributes(sequence)
+}
+
+func (v *generator_) convertType(model ModelLike, type_ typ.Type) AbstractionLike {
+	// Only the types that a model can express are converted.
+	var prefix PrefixLike
+	var identifier string
+	var arguments ArgumentsLike
+	switch actual := typ.Unalias(type_).(type) {
+	case *typ.Basic:
+		identifier = actual.Name()
+	case *typ.TypeParam:
+		identifier = actual.Obj().Name()
+	case *typ.Interface:
+		if actual.Empty() {
+			identifier = "any"
+		}
+	case *typ.Named:
+		var object = actual.Obj()
+		identifier = object.Name()
+		if object.Pkg() != nil {
+			var alias = v.retrieveModuleAlias(model, object.Pkg().Path())
+			if len(alias) > 0 {
+				prefix = Prefix().MakeWithAttributes(alias, AliasPrefix)
+			} else {
+				identifier = ""
+			}
+		}
+		var typeArguments = actual.TypeArgs()
+		if typeArguments.Len() > 0 {
+			var sequence = col.List[AbstractionLike]().Make()
+			for index := range typeArguments.Len() {
+				var argument = v.convertType(model, typeArguments.At(index))
+				sequence.AppendValue(argument)
+			}
+			arguments = Arguments().MakeWithAttributes(sequence)
+		}
+	case *typ.Slice:
+		var element = v.convertType(model, actual.Elem())
+		if element.GetPrefix() == nil {
+			prefix = Prefix().MakeWithAttributes("", ArrayPrefix)
+			identifier = element.GetIdentifier()
+			arguments = element.GetArguments()
+		}
+	case *typ.Map:
+		var key = v.convertType(model, actual.Key())
+		var element = v.convertType(model, actual.Elem())
+		if key.GetPrefix() == nil && key.GetArguments() == nil &&
+			element.GetPrefix() == nil {
+			prefix = Prefix().MakeWithAttributes(key.GetIdentifier(), MapPrefix)
+			identifier = element.GetIdentifier()
+			arguments = element.GetArguments()
+		}
+	case *typ.Chan:
+		var element = v.convertType(model, actual.Elem())
+		if element.GetPrefix() == nil {
+			prefix = Prefix().MakeWithAttributes("", ChannelPrefix)
+			identifier = element.GetIdentifier()
+			arguments = element.GetArguments()
+		}
+	}
+	if len(identifier) == 0 {
+		var message = fmt.Sprintf(
+			"The imported type %v cannot be expressed using the model and its imports.",
+			type_,
+		)
+		panic(message)
+	}
+	return Abstraction().MakeWithAttributes(prefix, identifier, arguments)
+}
+
 func (v *generator_) createDirectory(directory string) {
 	if !sts.HasSuffix(directory, "/") {
 		directory += "/"
@@ -128,6 +419,194 @@ func (v *generator_) createDirectory(directory string) {
 	}
 }
 
+func (v *generator_) extractAssignments(
+	instanceInterface InstanceLike,
+	constructor ConstructorLike,
+) []fieldData_ {
+	var assignments []fieldData_
+	var parameters = constructor.GetParameters()
+	if parameters == nil {
+		// A constructor without parameters assigns any default values.
+		if instanceInterface == nil || instanceInterface.GetAttributes() == nil {
+			return assignments
+		}
+		var attributes = instanceInterface.GetAttributes()
+		var catalog = col.Catalog[string, string]().Make()
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			var defaultValue = attribute.GetDefault()
+			if len(defaultValue) > 0 {
+				var fieldName = v.extractFieldName(attribute.GetIdentifier())
+				catalog.SetValue(fieldName, defaultValue)
+			}
+		}
+		var defaults = catalog.GetIterator()
+		for defaults.HasNext() {
+			var association = defaults.GetNext()
+			assignments = append(assignments, fieldData_{
+				Name:  association.GetKey(),
+				Value: association.GetValue(),
+			})
+		}
+		return assignments
+	}
+	if !sts.HasPrefix(constructor.GetIdentifier(), "MakeWith") {
+		return assignments
+	}
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameterName = iterator.GetNext().GetIdentifier()
+		assignments = append(assignments, fieldData_{
+			Name:  sts.TrimSuffix(parameterName, "_"),
+			Value: parameterName,
+		})
+	}
+	return assignments
+}
+
+func (v *generator_) extractClassData(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) *classData_ {
+	var formatter = Formatter().Make()
+	var declaration = classInterface.GetDeclaration()
+	var className = sts.TrimSuffix(declaration.GetIdentifier(), "ClassLike")
+	var data = &classData_{
+		Notice:  model.GetNotice().GetComment(),
+		Package: model.GetHeader().GetIdentifier(),
+		Name:    className,
+		Target:  v.makePrivate(className),
+		Comment: declaration.GetComment(),
+	}
+	var parameters = declaration.GetParameters()
+	if parameters != nil {
+		data.Parameters = formatter.FormatParameters(parameters)
+		data.Arguments = formatter.FormatParameterNames(parameters)
+	}
+	var constants = classInterface.GetConstants()
+	if constants != nil {
+		var iterator = constants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			data.Constants = append(data.Constants, constantData_{
+				Name:    constant.GetIdentifier(),
+				Field:   v.makePrivate(constant.GetIdentifier()),
+				Type:    formatter.FormatAbstraction(constant.GetAbstraction()),
+				Value:   constant.GetValue(),
+				Comment: constant.GetComment(),
+			})
+		}
+	}
+	var constructors = classInterface.GetConstructors()
+	if constructors != nil {
+		var iterator = constructors.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constructor = iterator.GetNext()
+			var result = Result().MakeWithAbstraction(constructor.GetAbstraction())
+			var method = Method().MakeWithAttributes(
+				constructor.GetComment(),
+				constructor.GetIdentifier(),
+				constructor.GetParameters(),
+				result,
+			)
+			var constructorData = v.extractMethodData(method)
+			if instanceInterface != nil {
+				constructorData.Assignments = v.extractAssignments(
+					instanceInterface,
+					constructor,
+				)
+			}
+			if v.options_.ContainsValue(NilChecksOption) {
+				constructorData.Checks = v.extractNilChecks(model, constructor)
+			}
+			data.Constructors = append(data.Constructors, constructorData)
+		}
+	}
+	var functions = classInterface.GetFunctions()
+	if functions != nil {
+		var iterator = functions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var function = iterator.GetNext()
+			var method = Method().MakeWithAttributes(
+				function.GetComment(),
+				function.GetIdentifier(),
+				function.GetParameters(),
+				function.GetResult(),
+			)
+			data.Functions = append(data.Functions, v.extractMethodData(method))
+		}
+	}
+	if instanceInterface != nil {
+		var instance = v.extractInstanceData(model, classInterface, instanceInterface)
+		if v.options_.ContainsValue(ValueMethodsOption) {
+			instance.Value = v.extractValueData(model, classInterface, instanceInterface)
+		}
+		if v.options_.ContainsValue(SerializationOption) {
+			instance.Serialization = v.extractSerializationData(
+				model,
+				classInterface,
+				instanceInterface,
+			)
+		}
+		data.Instance = instance
+	}
+	return data
+}
+
+func (v *generator_) extractClassMethods(
+	classInterface ClassLike,
+) col.Sequential[MethodLike] {
+	// Each class constant, constructor and function is treated as a method.
+	var catalog = col.Catalog[string, MethodLike]().Make()
+	var constants = classInterface.GetConstants()
+	if constants != nil {
+		var iterator = constants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			var result = Result().MakeWithAbstraction(constant.GetAbstraction())
+			var method = Method().MakeWithAttributes(
+				constant.GetComment(),
+				constant.GetIdentifier(),
+				nil,
+				result,
+			)
+			v.extractMethod(method, catalog)
+		}
+	}
+	var constructors = classInterface.GetConstructors()
+	if constructors != nil {
+		var iterator = constructors.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constructor = iterator.GetNext()
+			var result = Result().MakeWithAbstraction(constructor.GetAbstraction())
+			var method = Method().MakeWithAttributes(
+				constructor.GetComment(),
+				constructor.GetIdentifier(),
+				constructor.GetParameters(),
+				result,
+			)
+			v.extractMethod(method, catalog)
+		}
+	}
+	var functions = classInterface.GetFunctions()
+	if functions != nil {
+		var iterator = functions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var function = iterator.GetNext()
+			var method = Method().MakeWithAttributes(
+				function.GetComment(),
+				function.GetIdentifier(),
+				function.GetParameters(),
+				function.GetResult(),
+			)
+			v.extractMethod(method, catalog)
+		}
+	}
+	return catalog.GetValues(catalog.GetKeys())
+}
+
 func (v *generator_) extractConstructorAttributes(
 	class ClassLike,
 	catalog col.CatalogLike[string, string],
@@ -146,148 +625,676 @@ func (v *generator_) extractConstructorAttributes(
 	}
 }
 
+func (v *generator_) extractFieldName(attributeName string) string {
+	// This is the only place that knows the attribute method prefixes so that
+	// the fields, their assignments and their defaults always agree.
+	for _, prefix := range []string{"Get", "Set", "Is", "Are", "Was", "Were", "Has", "Had"} {
+		if sts.HasPrefix(attributeName, prefix) {
+			attributeName = sts.TrimPrefix(attributeName, prefix)
+			break
+		}
+	}
+	return v.makePrivate(attributeName)
+}
+
+func (v *generator_) extractFields(
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) []fieldData_ {
+	var fields []fieldData_
+	var catalog = col.Catalog[string, string]().Make()
+	v.extractInstanceAttributes(instanceInterface, catalog)
+	v.extractConstructorAttributes(classInterface, catalog)
+	var iterator = catalog.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		fields = append(fields, fieldData_{
+			Name: association.GetKey(),
+			Type: association.GetValue(),
+		})
+	}
+	return fields
+}
+
 func (v *generator_) extractInstanceAttributes(
 	instance InstanceLike,
 	catalog col.CatalogLike[string, string],
 ) {
-	var attributeName string
-	var attributeType string
 	var formatter = Formatter().Make()
 	var attributes = instance.GetAttributes()
 	if attributes != nil {
 		var iterator = attributes.GetSequence().GetIterator()
 		for iterator.HasNext() {
 			var attribute = iterator.GetNext()
-			var identifier = attribute.GetIdentifier()
-			var abstraction AbstractionLike
-			switch {
-			case sts.HasPrefix(identifier, "Get"):
-				attributeName = sts.TrimPrefix(identifier, "Get")
-				abstraction = attribute.GetAbstraction()
-			case sts.HasPrefix(identifier, "Is"):
-				attributeName = sts.TrimPrefix(identifier, "Is")
-				abstraction = attribute.GetAbstraction()
-			case sts.HasPrefix(identifier, "Was"):
-				attributeName = sts.TrimPrefix(identifier, "Was")
-				abstraction = attribute.GetAbstraction()
-			case sts.HasPrefix(identifier, "Has"):
-				attributeName = sts.TrimPrefix(identifier, "Has")
-				abstraction = attribute.GetAbstraction()
-			default:
-				if attributeName == v.makePrivate(sts.TrimPrefix(identifier, "Set")) {
-					// This attribute was already added.
-					continue
-				}
-				attributeName = sts.TrimPrefix(identifier, "Set")
-				var parameter = attribute.GetParameter()
+			var abstraction = attribute.GetAbstraction()
+			var parameter = attribute.GetParameter()
+			if parameter != nil {
+				// A setter and getter for the same attribute share a field.
 				abstraction = parameter.GetAbstraction()
 			}
-			attributeName = v.makePrivate(attributeName)
-			attributeType = formatter.FormatAbstraction(abstraction)
+			var attributeName = v.extractFieldName(attribute.GetIdentifier())
+			var attributeType = formatter.FormatAbstraction(abstraction)
 			catalog.SetValue(attributeName, attributeType)
 		}
 	}
 }
 
-func (v *generator_) extractParameterAttributes(
-	parameters ParametersLike,
-	catalog col.CatalogLike[string, string],
-) {
+func (v *generator_) extractInstanceData(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) *instanceData_ {
 	var formatter = Formatter().Make()
-	var iterator = parameters.GetSequence().GetIterator()
-	for iterator.HasNext() {
-		var attribute = iterator.GetNext()
-		var attributeName = attribute.GetIdentifier()
-		attributeName = sts.TrimSuffix(attributeName, "_")
-		var abstraction = attribute.GetAbstraction()
-		var attributeType = formatter.FormatAbstraction(abstraction)
-		catalog.SetValue(attributeName, attributeType)
+	var declaration = instanceInterface.GetDeclaration()
+	var data = &instanceData_{
+		Name:    declaration.GetIdentifier(),
+		Comment: declaration.GetComment(),
+	}
+	data.Fields = v.extractFields(classInterface, instanceInterface)
+	var attributes = instanceInterface.GetAttributes()
+	if attributes != nil {
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			var attributeData = attributeData_{
+				Name:    attribute.GetIdentifier(),
+				Field:   v.extractFieldName(attribute.GetIdentifier()),
+				Default: attribute.GetDefault(),
+				Comment: attribute.GetComment(),
+			}
+			var parameter = attribute.GetParameter()
+			if parameter != nil {
+				attributeData.Parameter = formatter.FormatParameter(parameter)
+				attributeData.Argument = parameter.GetIdentifier()
+			}
+			var abstraction = attribute.GetAbstraction()
+			if abstraction != nil {
+				attributeData.Result = formatter.FormatAbstraction(abstraction)
+			}
+			data.Attributes = append(data.Attributes, attributeData)
+		}
+	}
+	var abstractions = instanceInterface.GetAbstractions()
+	if abstractions != nil {
+		var iterator = abstractions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var abstraction = iterator.GetNext()
+			var abstractionData = abstractionData_{
+				Name: formatter.FormatAbstraction(abstraction),
+			}
+			var alias string // The class is part of the same package.
+			var methods = v.retrieveMethods(model, abstraction, alias, nil)
+			var methodIterator = methods.GetIterator()
+			for methodIterator.HasNext() {
+				var method = v.extractMethodData(methodIterator.GetNext())
+				abstractionData.Methods = append(abstractionData.Methods, method)
+			}
+			data.Abstractions = append(data.Abstractions, abstractionData)
+		}
+	}
+	var methods = instanceInterface.GetMethods()
+	if methods != nil {
+		var iterator = methods.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var method = v.extractMethodData(iterator.GetNext())
+			data.Methods = append(data.Methods, method)
+		}
 	}
+	return data
 }
 
-func (v *generator_) generateAbstractionMethods(
-	aspect AspectLike,
-	abstraction AbstractionLike,
-) string {
-	var formatter = Formatter().Make()
-	var aspectDeclaration = aspect.GetDeclaration()
-	var genericTypes = aspectDeclaration.GetParameters()
-	var concreteTypes = abstraction.GetArguments()
-	var abstractionMethods string
-	var aspectMethods = aspect.GetMethods()
-	if aspectMethods == nil {
-		return abstractionMethods
+func (v *generator_) extractLocalNames(model ModelLike) col.SetLike[string] {
+	var names = col.Set[string]().Make()
+	var types = model.GetTypes()
+	if types != nil {
+		var specializations = types.GetSpecializations()
+		if specializations != nil {
+			var iterator = specializations.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var declaration = iterator.GetNext().GetDeclaration()
+				names.AddValue(declaration.GetIdentifier())
+			}
+		}
+		var functionals = types.GetFunctionals()
+		if functionals != nil {
+			var iterator = functionals.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var declaration = iterator.GetNext().GetDeclaration()
+				names.AddValue(declaration.GetIdentifier())
+			}
+		}
 	}
-	var iterator = aspectMethods.GetSequence().GetIterator()
-	for iterator.HasNext() {
-		var aspectMethod = iterator.GetNext()
-		var methodName = aspectMethod.GetIdentifier()
-		var methodParameters = aspectMethod.GetParameters()
-		var parameters string
-		if methodParameters != nil {
-			if genericTypes != nil {
-				// Replace the generic type names from the aspect definition
-				// with the actual types defined in the instance interface.
-				methodParameters = v.replaceParameterTypes(
-					genericTypes,
-					concreteTypes,
-					methodParameters,
-				)
+	var interfaces = model.GetInterfaces()
+	if interfaces != nil {
+		var aspects = interfaces.GetAspects()
+		if aspects != nil {
+			var iterator = aspects.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var declaration = iterator.GetNext().GetDeclaration()
+				names.AddValue(declaration.GetIdentifier())
 			}
-			parameters = formatter.FormatParameters(methodParameters)
 		}
-		var resultType string
-		var body = methodBodyTemplate_
-		var methodResult = aspectMethod.GetResult()
-		if methodResult != nil {
-			if genericTypes != nil {
-				// Replace the generic type names from the aspect definition
-				// with the actual types defined in the instance interface.
-				methodResult = v.replaceResultTypes(
-					genericTypes,
-					concreteTypes,
-					methodResult,
-				)
+		var classes = interfaces.GetClasses()
+		if classes != nil {
+			var iterator = classes.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var declaration = iterator.GetNext().GetDeclaration()
+				names.AddValue(declaration.GetIdentifier())
 			}
-			resultType = " " + formatter.FormatResult(methodResult)
-			if methodResult.GetAbstraction() != nil {
-				body = resultBodyTemplate_
-			} else {
-				body = returnBodyTemplate_
+		}
+		var instances = interfaces.GetInstances()
+		if instances != nil {
+			var iterator = instances.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var declaration = iterator.GetNext().GetDeclaration()
+				names.AddValue(declaration.GetIdentifier())
 			}
 		}
-		var method = instanceMethodTemplate_
-		method = sts.ReplaceAll(method, "<Body>", body)
-		method = sts.ReplaceAll(method, "<MethodName>", methodName)
-		method = sts.ReplaceAll(method, "<Parameters>", parameters)
-		method = sts.ReplaceAll(method, "<ResultType>", resultType)
-		abstractionMethods += method + "\n"
 	}
-	return abstractionMethods
+	return names
 }
 
-func (v *generator_) generateAbstractions(
-	model ModelLike,
-	instanceInterface InstanceLike,
-) string {
-	var formatter = Formatter().Make()
-	var result string
-	var abstractions = instanceInterface.GetAbstractions()
-	if abstractions == nil {
-		return result
+func (v *generator_) extractMethod(
+	method MethodLike,
+	catalog col.CatalogLike[string, MethodLike],
+) {
+	var methodName = method.GetIdentifier()
+	var existing = catalog.GetValue(methodName)
+	if existing != nil {
+		// The first method signature with a given name wins.
+		return
 	}
-	var iterator = abstractions.GetSequence().GetIterator()
+	catalog.SetValue(methodName, method)
+}
+
+func (v *generator_) extractMethodData(method MethodLike) methodData_ {
+	var formatter = Formatter().Make()
+	var data = methodData_{
+		Name:    method.GetIdentifier(),
+		Comment: method.GetComment(),
+	}
+	var parameters = method.GetParameters()
+	if parameters != nil {
+		data.Parameters = formatter.FormatParameters(parameters)
+		var names []string
+		var iterator = parameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var parameter = iterator.GetNext()
+			var name = parameter.GetIdentifier()
+			if parameter.IsVariadic() {
+				name += "..."
+			}
+			names = append(names, name)
+		}
+		data.Arguments = sts.Join(names, ", ")
+	}
+	var result = method.GetResult()
+	if result != nil {
+		data.Result = formatter.FormatResult(result)
+		data.IsNamed = result.GetAbstraction() == nil
+	}
+	return data
+}
+
+func (v *generator_) extractMethodNames(
+	model ModelLike,
+	instanceInterface InstanceLike,
+) col.SetLike[string] {
+	var names = col.Set[string]().Make()
+	var locals = v.extractLocalNames(model)
+	var methods = v.extractMethods(model, instanceInterface, "", locals)
+	var iterator = methods.GetIterator()
+	for iterator.HasNext() {
+		names.AddValue(iterator.GetNext().GetIdentifier())
+	}
+	return names
+}
+
+func (v *generator_) extractMethods(
+	model ModelLike,
+	instanceInterface InstanceLike,
+	alias string,
+	locals col.SetLike[string],
+) col.Sequential[MethodLike] {
+	var catalog = col.Catalog[string, MethodLike]().Make()
+
+	// Each attribute is a getter or setter method.
+	var attributes = instanceInterface.GetAttributes()
+	if attributes != nil {
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			var parameters ParametersLike
+			var parameter = attribute.GetParameter()
+			if parameter != nil {
+				var sequence = col.List[ParameterLike]().Make()
+				sequence.AppendValue(parameter)
+				parameters = Parameters().MakeWithAttributes(sequence)
+			}
+			var result ResultLike
+			var abstraction = attribute.GetAbstraction()
+			if abstraction != nil {
+				result = Result().MakeWithAbstraction(abstraction)
+			}
+			var method = Method().MakeWithAttributes(
+				attribute.GetComment(),
+				attribute.GetIdentifier(),
+				parameters,
+				result,
+			)
+			method = v.qualifyMethod(alias, locals, method)
+			v.extractMethod(method, catalog)
+		}
+	}
+
+	// Add the methods inherited from the local and imported abstractions.
+	var abstractions = instanceInterface.GetAbstractions()
+	if abstractions != nil {
+		var iterator = abstractions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var abstraction = iterator.GetNext()
+			var methods = v.retrieveMethods(model, abstraction, alias, locals).GetIterator()
+			for methods.HasNext() {
+				v.extractMethod(methods.GetNext(), catalog)
+			}
+		}
+	}
+
+	// Add the public methods.
+	var methods = instanceInterface.GetMethods()
+	if methods != nil {
+		var iterator = methods.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var method = v.qualifyMethod(alias, locals, iterator.GetNext())
+			v.extractMethod(method, catalog)
+		}
+	}
+	return catalog.GetValues(catalog.GetKeys())
+}
+
+func (v *generator_) extractModules(
+	model ModelLike,
+	selectors col.SetLike[string],
+	catalog col.CatalogLike[string, string],
+) {
+	// The modules imported by the model take precedence over the modules
+	// that are referenced by the templates themselves.
+	var aliases = col.Set[string]().Make()
+	var packageImports = model.GetImports()
+	if packageImports != nil {
+		var packageModules = packageImports.GetModules()
+		if packageModules != nil {
+			var iterator = packageModules.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var packageModule = iterator.GetNext()
+				var identifier = packageModule.GetIdentifier()
+				aliases.AddValue(identifier)
+				if selectors.ContainsValue(identifier) {
+					catalog.SetValue(packageModule.GetText(), identifier)
+				}
+			}
+		}
+	}
+	for alias, path := range templateModules_ {
+		if selectors.ContainsValue(alias) && !aliases.ContainsValue(alias) {
+			catalog.SetValue(path, alias)
+		}
+	}
+}
+
+func (v *generator_) extractNilChecks(
+	model ModelLike,
+	constructor ConstructorLike,
+) []string {
+	var checks []string
+	var parameters = constructor.GetParameters()
+	if parameters == nil {
+		return checks
+	}
+
+	// The comment of a constructor may name the parameters that may be nil.
+	var optionals = col.Set[string]().Make()
+	var lines = sts.Split(constructor.GetComment(), "\n")
+	for _, line := range lines {
+		line = sts.TrimSpace(line)
+		if !sts.HasPrefix(line, "optional:") {
+			continue
+		}
+		var names = sts.Split(sts.TrimPrefix(line, "optional:"), ",")
+		for _, name := range names {
+			optionals.AddValue(sts.TrimSpace(name))
+		}
+	}
+
+	var names = col.Set[string]().Make()
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		var identifier = parameter.GetIdentifier()
+		names.AddValue(identifier)
+		if parameter.IsVariadic() || optionals.ContainsValue(identifier) {
+			continue
+		}
+		if !v.isNillable(model, parameter.GetAbstraction()) {
+			continue
+		}
+		checks = append(checks, identifier)
+	}
+	var optionalIterator = optionals.GetIterator()
+	for optionalIterator.HasNext() {
+		var name = optionalIterator.GetNext()
+		if !names.ContainsValue(name) {
+			var message = fmt.Sprintf(
+				"The optional parameter %v is not a parameter of the constructor %v.",
+				name,
+				constructor.GetIdentifier(),
+			)
+			panic(message)
+		}
+	}
+	return checks
+}
+
+func (v *generator_) extractParameterAttributes(
+	parameters ParametersLike,
+	catalog col.CatalogLike[string, string],
+) {
+	var formatter = Formatter().Make()
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var attribute = iterator.GetNext()
+		var attributeName = attribute.GetIdentifier()
+		attributeName = sts.TrimSuffix(attributeName, "_")
+		var abstraction = attribute.GetAbstraction()
+		var attributeType = formatter.FormatAbstraction(abstraction)
+		if attribute.IsVariadic() {
+			// A variadic parameter is passed in as a slice.
+			attributeType = "[]" + attributeType
+		}
+		catalog.SetValue(attributeName, attributeType)
+	}
+}
+
+func (v *generator_) extractReaders(
+	model ModelLike,
+	instanceInterface InstanceLike,
+	readers col.SetLike[string],
+) {
+	// The getter attributes include those inherited from any embedded local
+	// instance interfaces.
+	var attributes = instanceInterface.GetAttributes()
+	if attributes != nil {
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			if attribute.GetParameter() == nil {
+				readers.AddValue(attribute.GetIdentifier())
+			}
+		}
+	}
+	var abstractions = instanceInterface.GetAbstractions()
+	if abstractions != nil {
+		var iterator = abstractions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var abstraction = iterator.GetNext()
+			if abstraction.GetPrefix() != nil {
+				continue
+			}
+			var instance = v.retrieveInstance(model, abstraction.GetIdentifier())
+			if instance != nil {
+				v.extractReaders(model, instance, readers)
+			}
+		}
+	}
+}
+
+func (v *generator_) extractSelectors(
+	model ModelLike,
+	source string,
+) col.SetLike[string] {
+	// The only package names that may be referenced are the aliases of the
+	// modules imported by the model or used by the templates, and the name of
+	// the package itself (from its mocks).
+	var aliases = col.Set[string]().Make()
+	aliases.AddValue(model.GetHeader().GetIdentifier())
+	var imports = model.GetImports()
+	if imports != nil && imports.GetModules() != nil {
+		var iterator = imports.GetModules().GetSequence().GetIterator()
+		for iterator.HasNext() {
+			aliases.AddValue(iterator.GetNext().GetIdentifier())
+		}
+	}
+	for alias := range templateModules_ {
+		aliases.AddValue(alias)
+	}
+
+	// Collect the package names that are referenced by qualified identifiers
+	// in the source code, ignoring any that appear in comments or strings.
+	var selectors = col.Set[string]().Make()
+	source = sts.ReplaceAll(source, "<Imports>", "")
+	var fileSet = tok.NewFileSet()
+	var file, err = par.ParseFile(fileSet, "", source, par.SkipObjectResolution)
+	if err != nil {
+		// Invalid source code is reported with its position once the file is
+		// formatted.
+		return selectors
+	}
+	ast.Inspect(file, func(node ast.Node) bool {
+		var selector, ok = node.(*ast.SelectorExpr)
+		if ok {
+			var identifier, ok = selector.X.(*ast.Ident)
+			if ok && aliases.ContainsValue(identifier.Name) {
+				selectors.AddValue(identifier.Name)
+			}
+		}
+		return true
+	})
+	return selectors
+}
+
+func (v *generator_) extractSerializationData(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) *serializationData_ {
+	// Only the instances of a class whose state is fully described by the
+	// parameters of its MakeWithAttributes constructor can be serialized.
+	var constructor = v.retrieveConstructor(classInterface, "MakeWithAttributes")
+	if constructor == nil || constructor.GetParameters() == nil {
+		return nil
+	}
+
+	// The fields include the parameters of every constructor so any other
+	// field would be lost by a round trip.
+	var fields = v.extractFields(classInterface, instanceInterface)
+	if len(fields) != constructor.GetParameters().GetSequence().GetSize() {
+		return nil
+	}
+	var typeParameters = col.Set[string]().Make()
+	var classParameters = classInterface.GetDeclaration().GetParameters()
+	if classParameters != nil {
+		var iterator = classParameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			typeParameters.AddValue(iterator.GetNext().GetIdentifier())
+		}
+	}
+	var formatter = Formatter().Make()
+	var data = &serializationData_{}
+	var iterator = constructor.GetParameters().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		var abstraction = parameter.GetAbstraction()
+		if !v.isSerializable(model, typeParameters, abstraction) {
+			return nil
+		}
+		var parameterType = formatter.FormatAbstraction(abstraction)
+		if parameter.IsVariadic() {
+			// A variadic parameter is passed in as a slice.
+			parameterType = "[]" + parameterType
+		}
+		data.Parameters = append(data.Parameters, parameterData_{
+			Name:       parameter.GetIdentifier(),
+			Field:      sts.TrimSuffix(parameter.GetIdentifier(), "_"),
+			Type:       parameterType,
+			IsVariadic: parameter.IsVariadic(),
+		})
+	}
+
+	// Any serialization methods that are declared by the instance interface
+	// must be implemented by hand instead.
+	var declared = v.extractMethodNames(model, instanceInterface)
+	var methodNames = []string{"MarshalJSON", "UnmarshalJSON", "MarshalCDCN", "UnmarshalCDCN"}
+	for _, methodName := range methodNames {
+		if !declared.ContainsValue(methodName) {
+			data.Methods = append(data.Methods, methodName)
+		}
+	}
+	if len(data.Methods) == 0 {
+		return nil
+	}
+	return data
+}
+
+func (v *generator_) extractValueData(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) *valueData_ {
+	var fields = v.extractFields(classInterface, instanceInterface)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	// Slice, map and sequential attributes are handled element by element.
+	var data = &valueData_{}
+	var isCopyable = true
+	for _, field := range fields {
+		var fieldData = valueFieldData_{
+			Name: field.Name,
+			Type: field.Type,
+			Kind: "value",
+		}
+		switch {
+		case sts.HasPrefix(field.Type, "[]"):
+			fieldData.Kind = "slice"
+			fieldData.ElementType = sts.TrimPrefix(field.Type, "[]")
+		case sts.HasPrefix(field.Type, "map["):
+			fieldData.Kind = "map"
+			fieldData.ElementType = field.Type[sts.Index(field.Type, "]")+1:]
+		case v.isSequential(field.Type):
+			fieldData.Kind = "sequence"
+			var start = sts.Index(field.Type, "[") + 1
+			fieldData.ElementType = field.Type[start : len(field.Type)-1]
+
+			// Only the sequences from the collection framework can be copied
+			// since the other sequences have no known constructor.
+			var index = sts.Index(field.Type, ".")
+			if index >= 0 && index < start {
+				fieldData.Alias = field.Type[:index]
+			}
+			var path string
+			if len(fieldData.Alias) > 0 {
+				path = v.retrieveModulePath(model, fieldData.Alias)
+			}
+			if !sts.HasPrefix(path, "github.com/craterdog/go-collection-framework/") {
+				isCopyable = false
+			}
+		case v.isFunctional(model, field.Type):
+			fieldData.Kind = "functional"
+		}
+		var valueType = field.Type
+		if len(fieldData.ElementType) > 0 {
+			valueType = fieldData.ElementType
+		}
+		fieldData.IsComparable = v.isComparable(valueType)
+		data.Fields = append(data.Fields, fieldData)
+	}
+
+	// Any value methods that are declared by the instance interface must be
+	// implemented by hand instead.
+	var declared = v.extractMethodNames(model, instanceInterface)
+	for _, methodName := range []string{"Equal", "Copy", "String"} {
+		if methodName == "Copy" && !isCopyable {
+			continue
+		}
+		if !declared.ContainsValue(methodName) {
+			data.Methods = append(data.Methods, methodName)
+		}
+	}
+	if len(data.Methods) == 0 {
+		return nil
+	}
+	return data
+}
+
+func (v *generator_) formatSource(file string, source string) string {
+	var bytes, err = gof.Source([]byte(source))
+	if err != nil {
+		// The error positions are relative to the start of the file.
+		var message = fmt.Sprintf(
+			"The generated file is not valid Go source:\n%v:%v\n",
+			file,
+			err,
+		)
+		panic(message)
+	}
+	return string(bytes)
+}
+
+func (v *generator_) generateAbstractionMethods(
+	methods col.Sequential[MethodLike],
+) string {
+	var formatter = Formatter().Make()
+	var abstractionMethods string
+	var iterator = methods.GetIterator()
+	for iterator.HasNext() {
+		var abstractionMethod = iterator.GetNext()
+		var methodName = abstractionMethod.GetIdentifier()
+		var methodParameters = abstractionMethod.GetParameters()
+		var parameters string
+		if methodParameters != nil {
+			parameters = formatter.FormatParameters(methodParameters)
+		}
+		var resultType string
+		var body = methodBodyTemplate_
+		var methodResult = abstractionMethod.GetResult()
+		if methodResult != nil {
+			resultType = " " + formatter.FormatResult(methodResult)
+			if methodResult.GetAbstraction() != nil {
+				body = resultBodyTemplate_
+			} else {
+				body = returnBodyTemplate_
+			}
+		}
+		var method = instanceMethodTemplate_
+		method = sts.ReplaceAll(method, "<Body>", body)
+		method = sts.ReplaceAll(method, "<MethodName>", methodName)
+		method = sts.ReplaceAll(method, "<Parameters>", parameters)
+		method = sts.ReplaceAll(method, "<ResultType>", resultType)
+		var comment = abstractionMethod.GetComment()
+		method = sts.ReplaceAll(method, "<Comment>", comment)
+		abstractionMethods += method + "\n"
+	}
+	return abstractionMethods
+}
+
+func (v *generator_) generateAbstractions(
+	model ModelLike,
+	instanceInterface InstanceLike,
+) string {
+	var formatter = Formatter().Make()
+	var result string
+	var abstractions = instanceInterface.GetAbstractions()
+	if abstractions == nil {
+		return result
+	}
+	var iterator = abstractions.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var abstraction = iterator.GetNext()
-		var prefix = abstraction.GetPrefix()
-		var identifier = abstraction.GetIdentifier()
 		var aspectName = formatter.FormatAbstraction(abstraction)
-		var methods string
-		if prefix == nil {
-			// We only know the method signatures for the local aspects.
-			var aspect = v.retrieveAspect(model, identifier)
-			methods = v.generateAbstractionMethods(aspect, abstraction)
-		}
+		var alias string // The stubs are part of the same package.
+		var sequence = v.retrieveMethods(model, abstraction, alias, nil)
+		var methods = v.generateAbstractionMethods(sequence)
 		var instanceAspect = instanceAspectTemplate_
 		instanceAspect = sts.ReplaceAll(instanceAspect, "<AspectName>", aspectName)
 		instanceAspect = sts.ReplaceAll(instanceAspect, "<Methods>", methods)
@@ -297,23 +1304,18 @@ func (v *generator_) generateAbstractions(
 }
 
 func (v *generator_) generateAttributeAssignments(
-	classInterface ClassLike,
+	instanceInterface InstanceLike,
 	constructor ConstructorLike,
 ) string {
 	var assignments string
-	var identifier = constructor.GetIdentifier()
-	if !sts.HasPrefix(identifier, "MakeWith") {
+	var fields = v.extractAssignments(instanceInterface, constructor)
+	if len(fields) == 0 {
 		return assignments
 	}
-	var parameters = constructor.GetParameters()
-	var iterator = parameters.GetSequence().GetIterator()
-	for iterator.HasNext() {
-		var parameter = iterator.GetNext()
-		var parameterName = parameter.GetIdentifier()
-		var attributeName = sts.TrimSuffix(parameterName, "_")
+	for _, field := range fields {
 		var assignment = attributeAssignmentTemplate_
-		assignment = sts.ReplaceAll(assignment, "<AttributeName>", attributeName)
-		assignment = sts.ReplaceAll(assignment, "<ParameterName>", parameterName)
+		assignment = sts.ReplaceAll(assignment, "<AttributeName>", field.Name)
+		assignment = sts.ReplaceAll(assignment, "<ParameterName>", field.Value)
 		assignments += assignment
 	}
 	assignments += "\n\t"
@@ -331,14 +1333,13 @@ func (v *generator_) generateAttributeMethods(instanceInterface InstanceLike) st
 	for iterator.HasNext() {
 		var attribute = iterator.GetNext()
 		var methodName = attribute.GetIdentifier()
-		var attributeName string
+		var attributeName = v.extractFieldName(methodName)
 		var body string
 
 		var parameter string
 		var attributeParameter = attribute.GetParameter()
 		var parameterName string
 		if attributeParameter != nil {
-			attributeName = sts.TrimPrefix(methodName, "Set")
 			parameterName = attributeParameter.GetIdentifier()
 			parameter = formatter.FormatParameter(attributeParameter)
 			body = setterBodyTemplate_
@@ -347,21 +1348,10 @@ func (v *generator_) generateAttributeMethods(instanceInterface InstanceLike) st
 		var resultType string
 		var abstraction = attribute.GetAbstraction()
 		if abstraction != nil {
-			switch {
-			case sts.HasPrefix(methodName, "Get"):
-				attributeName = sts.TrimPrefix(methodName, "Get")
-			case sts.HasPrefix(methodName, "Is"):
-				attributeName = sts.TrimPrefix(methodName, "Is")
-			case sts.HasPrefix(methodName, "Was"):
-				attributeName = sts.TrimPrefix(methodName, "Was")
-			case sts.HasPrefix(methodName, "Has"):
-				attributeName = sts.TrimPrefix(methodName, "Has")
-			}
 			resultType = " " + formatter.FormatAbstraction(abstraction)
 			body = getterBodyTemplate_
 		}
 
-		attributeName = v.makePrivate(attributeName)
 		body = sts.ReplaceAll(body, "<AttributeName>", attributeName)
 		body = sts.ReplaceAll(body, "<ParameterName>", parameterName)
 		var method = instanceMethodTemplate_
@@ -369,17 +1359,18 @@ func (v *generator_) generateAttributeMethods(instanceInterface InstanceLike) st
 		method = sts.ReplaceAll(method, "<MethodName>", methodName)
 		method = sts.ReplaceAll(method, "<Parameters>", parameter)
 		method = sts.ReplaceAll(method, "<ResultType>", resultType)
+		var comment = attribute.GetComment()
+		method = sts.ReplaceAll(method, "<Comment>", comment)
 		methods += method + "\n"
 	}
 	return methods
 }
 
 func (v *generator_) generateClass(
-	directory string,
 	model ModelLike,
 	classInterface ClassLike,
 	instanceInterface InstanceLike,
-) {
+) string {
 	var class = classTemplate_
 
 	var notice = model.GetNotice().GetComment()
@@ -388,17 +1379,25 @@ func (v *generator_) generateClass(
 	var header = v.generateHeader(model)
 	class = sts.ReplaceAll(class, "<Header>", header)
 
-	var classAccess = v.generateClassAccess(classInterface)
+	var classAccess = v.generateClassAccess(classInterface, instanceInterface)
 	class = sts.ReplaceAll(class, "<Access>", classAccess)
 
-	var classMethods = v.generateClassMethods(classInterface)
-	class = sts.ReplaceAll(class, "<Class>", classMethods)
-
-	var instanceMethods = v.generateInstanceMethods(
+	var classMethods = v.generateClassMethods(
 		model,
 		classInterface,
 		instanceInterface,
 	)
+	class = sts.ReplaceAll(class, "<Class>", classMethods)
+
+	// A standalone class interface only has class methods.
+	var instanceMethods string
+	if instanceInterface != nil {
+		instanceMethods = v.generateInstanceMethods(
+			model,
+			classInterface,
+			instanceInterface,
+		)
+	}
 	class = sts.ReplaceAll(class, "<Instance>", instanceMethods)
 
 	var classDeclaration = classInterface.GetDeclaration()
@@ -420,43 +1419,41 @@ func (v *generator_) generateClass(
 
 	var imports = v.generateImports(model, class)
 	class = sts.ReplaceAll(class, "<Imports>", imports)
-
-	var fileName = sts.ToLower(className)
-	var classFile = directory + fileName + ".go"
-	v.outputClass(classFile, class)
-}
-
-func (v *generator_) generateClasses(directory string, model ModelLike) {
-	var interfaces = model.GetInterfaces()
-	if interfaces == nil {
-		return
-	}
-	var classes = interfaces.GetClasses()
-	if classes == nil {
-		return
-	}
-	var classIterator = classes.GetSequence().GetIterator()
-	var instances = interfaces.GetInstances()
-	var instanceIterator = instances.GetSequence().GetIterator()
-	for classIterator.HasNext() {
-		var classInterface = classIterator.GetNext()
-		var instanceInterface = instanceIterator.GetNext()
-		v.generateClass(directory, model, classInterface, instanceInterface)
-	}
+	return class
 }
 
-func (v *generator_) generateClassAccess(classInterface ClassLike) string {
+func (v *generator_) generateClassAccess(
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) string {
 	var declaration = classInterface.GetDeclaration()
 	var parameters = declaration.GetParameters()
 	var reference = classReferenceTemplate_
 	var function = classFunctionTemplate_
+	var compliance = complianceTemplate_
+	var instanceCompliance = instanceComplianceTemplate_
+	var values = v.generateConstantValues(classInterface)
 	if parameters != nil {
 		reference = genericReferenceTemplate_
 		function = genericFunctionTemplate_
+		// A generic class can only be checked for compliance within the scope
+		// of its type parameters.
+		compliance = genericComplianceTemplate_
+		instanceCompliance = genericInstanceComplianceTemplate_
+		// The generic class reference is nested two levels deeper.
+		values = sts.ReplaceAll(values, "\n", "\n\t\t")
 	}
+	if instanceInterface == nil {
+		// A standalone class interface has no instances.
+		instanceCompliance = ""
+	}
+	compliance = sts.ReplaceAll(compliance, "<Instance>", instanceCompliance)
+	function = sts.ReplaceAll(function, "<Values>", values)
 	var access = classAccessTemplate_
 	access = sts.ReplaceAll(access, "<Reference>", reference)
 	access = sts.ReplaceAll(access, "<Function>", function)
+	access = sts.ReplaceAll(access, "<Compliance>", compliance)
+	access = sts.ReplaceAll(access, "<Values>", values)
 	return access + "\n"
 }
 
@@ -484,13 +1481,126 @@ func (v *generator_) generateClassConstants(classInterface ClassLike) string {
 	return constants
 }
 
-func (v *generator_) generateClassMethods(classInterface ClassLike) string {
-	var methods = classMethodsTemplate_
-	var target = v.generateClassTarget(classInterface)
-	methods = sts.ReplaceAll(methods, "<Target>", target)
-	var constantMethods = v.generateConstantMethods(classInterface)
-	methods = sts.ReplaceAll(methods, "<Constants>", constantMethods)
-	var constructorMethods = v.generateConstructorMethods(classInterface)
+func (v *generator_) generateClasses(
+	directory string,
+	model ModelLike,
+	files col.CatalogLike[string, string],
+) {
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil {
+		return
+	}
+	var classes = interfaces.GetClasses()
+	if classes == nil {
+		return
+	}
+
+	// Pair each class interface with the instance interface of the same name.
+	// Any standalone instance interfaces are implemented elsewhere so no class
+	// files are generated for them.
+	var catalog = col.Catalog[string, InstanceLike]().Make()
+	var instances = interfaces.GetInstances()
+	if instances != nil {
+		var instanceIterator = instances.GetSequence().GetIterator()
+		for instanceIterator.HasNext() {
+			var instanceInterface = instanceIterator.GetNext()
+			var identifier = instanceInterface.GetDeclaration().GetIdentifier()
+			catalog.SetValue(sts.TrimSuffix(identifier, "Like"), instanceInterface)
+		}
+	}
+
+	// The class files are generated concurrently by a bounded pool of workers
+	// but are collected in the order that their classes are declared so that
+	// the output and any errors are deterministic.
+	var classInterfaces = classes.GetSequence().AsArray()
+	var count = len(classInterfaces)
+	var fileNames = make([]string, count)
+	var sources = make([]string, count)
+	var failures = make([]string, count)
+	var indices = make(chan int)
+	var workers = min(run.GOMAXPROCS(0), count)
+	var group syn.WaitGroup
+	group.Add(workers)
+	for range workers {
+		go func() {
+			defer group.Done()
+			for index := range indices {
+				var classInterface = classInterfaces[index]
+				var identifier = classInterface.GetDeclaration().GetIdentifier()
+				var className = sts.TrimSuffix(identifier, "ClassLike")
+				var instanceInterface = catalog.GetValue(className)
+				var fileName = sts.ToLower(className) + ".go"
+				fileNames[index] = fileName
+				sources[index], failures[index] = v.generateClassFile(
+					directory+fileName,
+					model,
+					classInterface,
+					instanceInterface,
+				)
+			}
+		}()
+	}
+	for index := range count {
+		indices <- index
+	}
+	close(indices)
+	group.Wait()
+
+	var errors []string
+	for index, fileName := range fileNames {
+		if len(failures[index]) > 0 {
+			errors = append(errors, failures[index])
+			continue
+		}
+		files.SetValue(fileName, sources[index])
+	}
+	if len(errors) > 0 {
+		var message = fmt.Sprintf(
+			"The following class files could not be generated:\n%v\n",
+			sts.Join(errors, "\n"),
+		)
+		panic(message)
+	}
+}
+
+func (v *generator_) generateClassFile(
+	classFile string,
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) (class string, failure string) {
+	// Any panic is returned as a failure so that it can be aggregated with the
+	// failures from the other workers.
+	defer func() {
+		var result = recover()
+		if result != nil {
+			failure = sts.TrimSpace(fmt.Sprintf("%v", result))
+		}
+	}()
+	if v.templates_ != nil {
+		class = v.renderClass(model, classInterface, instanceInterface)
+	} else {
+		class = v.generateClass(model, classInterface, instanceInterface)
+	}
+	class = v.formatSource(classFile, class)
+	return class, failure
+}
+
+func (v *generator_) generateClassMethods(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) string {
+	var methods = classMethodsTemplate_
+	var target = v.generateClassTarget(classInterface)
+	methods = sts.ReplaceAll(methods, "<Target>", target)
+	var constantMethods = v.generateConstantMethods(classInterface)
+	methods = sts.ReplaceAll(methods, "<Constants>", constantMethods)
+	var constructorMethods = v.generateConstructorMethods(
+		model,
+		classInterface,
+		instanceInterface,
+	)
 	methods = sts.ReplaceAll(methods, "<Constructors>", constructorMethods)
 	var functionMethods = v.generateFunctionMethods(classInterface)
 	methods = sts.ReplaceAll(methods, "<Functions>", functionMethods)
@@ -525,15 +1635,54 @@ func (v *generator_) generateConstantMethods(classInterface ClassLike) string {
 		method = sts.ReplaceAll(method, "<MethodName>", methodName)
 		method = sts.ReplaceAll(method, "<Parameters>", "")
 		method = sts.ReplaceAll(method, "<ResultType>", resultType)
+		var comment = constant.GetComment()
+		method = sts.ReplaceAll(method, "<Comment>", comment)
 		methods += method + "\n"
 	}
 	return methods
 }
 
-func (v *generator_) generateConstructorMethods(classInterface ClassLike) string {
+func (v *generator_) generateConstantValues(classInterface ClassLike) string {
+	var values string
+	var isComplete = true
+	var classConstants = classInterface.GetConstants()
+	if classConstants == nil {
+		isComplete = false
+	} else {
+		var iterator = classConstants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			var constantValue = constant.GetValue()
+			if len(constantValue) == 0 {
+				// The value of this constant must be assigned manually.
+				isComplete = false
+				continue
+			}
+			var constantName = v.makePrivate(constant.GetIdentifier())
+			var value = constantValueTemplate_
+			value = sts.ReplaceAll(value, "<ConstantName>", constantName)
+			value = sts.ReplaceAll(value, "<ConstantValue>", constantValue)
+			values += value
+		}
+	}
+	if !isComplete {
+		values += "\n\t// TBA - Assign constant values."
+	}
+	return values
+}
+
+func (v *generator_) generateConstructorMethods(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) string {
 	var formatter = Formatter().Make()
 	var methods string
-	var iterator = classInterface.GetConstructors().GetSequence().GetIterator()
+	var constructors = classInterface.GetConstructors()
+	if constructors == nil {
+		return methods
+	}
+	var iterator = constructors.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var constructor = iterator.GetNext()
 		var methodName = constructor.GetIdentifier()
@@ -544,14 +1693,21 @@ func (v *generator_) generateConstructorMethods(classInterface ClassLike) string
 		}
 		var abstraction = constructor.GetAbstraction()
 		var resultType = " " + formatter.FormatAbstraction(abstraction)
-		var assignments = v.generateAttributeAssignments(classInterface, constructor)
+		var assignments = v.generateAttributeAssignments(instanceInterface, constructor)
+		var checks string
+		if v.options_.ContainsValue(NilChecksOption) {
+			checks = v.generateNilChecks(model, constructor)
+		}
 		var body = constructorBodyTemplate_
+		body = sts.ReplaceAll(body, "<Checks>", checks)
 		body = sts.ReplaceAll(body, "<Assignments>", assignments)
 		var method = classMethodTemplate_
 		method = sts.ReplaceAll(method, "<Body>", body)
 		method = sts.ReplaceAll(method, "<MethodName>", methodName)
 		method = sts.ReplaceAll(method, "<Parameters>", parameters)
 		method = sts.ReplaceAll(method, "<ResultType>", resultType)
+		var comment = constructor.GetComment()
+		method = sts.ReplaceAll(method, "<Comment>", comment)
 		methods += method + "\n"
 	}
 	return methods
@@ -581,6 +1737,8 @@ func (v *generator_) generateFunctionMethods(classInterface ClassLike) string {
 		method = sts.ReplaceAll(method, "<MethodName>", identifier)
 		method = sts.ReplaceAll(method, "<Parameters>", parameters)
 		method = sts.ReplaceAll(method, "<ResultType>", resultType)
+		var comment = function.GetComment()
+		method = sts.ReplaceAll(method, "<Comment>", comment)
 		methods += method + "\n"
 	}
 	return methods
@@ -595,26 +1753,10 @@ func (v *generator_) generateHeader(model ModelLike) string {
 
 func (v *generator_) generateImports(model ModelLike, class string) string {
 	var imports string
-	var modules string
-	var packageImports = model.GetImports()
-	if packageImports != nil {
-		var packageModules = packageImports.GetModules()
-		if packageModules != nil {
-			var iterator = packageModules.GetSequence().GetIterator()
-			for iterator.HasNext() {
-				var packageModule = iterator.GetNext()
-				var identifier = packageModule.GetIdentifier()
-				var text = packageModule.GetText()
-				if sts.Contains(class, identifier+".") {
-					modules += "\n\t" + identifier + " " + text
-				}
-			}
-		}
-	}
-	if sts.Contains(class, "syn.") {
-		modules += "\n\tfmt \"fmt\""
-		modules += "\n\tsyn \"sync\""
-	}
+	var selectors = v.extractSelectors(model, class)
+	var catalog = col.Catalog[string, string]().Make()
+	v.extractModules(model, selectors, catalog)
+	var modules = v.generateModules(catalog)
 	if len(modules) > 0 {
 		modules += "\n"
 	}
@@ -628,21 +1770,15 @@ func (v *generator_) generateInstanceAttributes(
 	instanceInterface InstanceLike,
 ) string {
 	var attributes string
-	var catalog = col.Catalog[string, string]().Make()
-	v.extractInstanceAttributes(instanceInterface, catalog)
-	v.extractConstructorAttributes(classInterface, catalog)
-	if catalog.IsEmpty() {
+	var fields = v.extractFields(classInterface, instanceInterface)
+	if len(fields) == 0 {
 		attributes = "\n\t// TBA - Add private instance attributes.\n"
 		return attributes
 	}
-	var iterator = catalog.GetIterator()
-	for iterator.HasNext() {
-		var association = iterator.GetNext()
-		var attributeName = association.GetKey()
-		var attributeType = association.GetValue()
+	for _, field := range fields {
 		var attribute = instanceAttributeTemplate_
-		attribute = sts.ReplaceAll(attribute, "<AttributeName>", attributeName)
-		attribute = sts.ReplaceAll(attribute, "<AttributeType>", attributeType)
+		attribute = sts.ReplaceAll(attribute, "<AttributeName>", field.Name)
+		attribute = sts.ReplaceAll(attribute, "<AttributeType>", field.Type)
 		attributes += attribute
 	}
 	attributes += "\n"
@@ -663,6 +1799,28 @@ func (v *generator_) generateInstanceMethods(
 	instanceMethods = sts.ReplaceAll(instanceMethods, "<Abstractions>", abstractions)
 	var methods = v.generatePublicMethods(instanceInterface)
 	instanceMethods = sts.ReplaceAll(instanceMethods, "<Methods>", methods)
+	var valueMethods string
+	if v.options_.ContainsValue(ValueMethodsOption) {
+		valueMethods = v.generateValueMethods(
+			model,
+			classInterface,
+			instanceInterface,
+		)
+	}
+	instanceMethods = sts.ReplaceAll(instanceMethods, "<ValueMethods>", valueMethods)
+	var serializationMethods string
+	if v.options_.ContainsValue(SerializationOption) {
+		serializationMethods = v.generateSerializationMethods(
+			model,
+			classInterface,
+			instanceInterface,
+		)
+	}
+	instanceMethods = sts.ReplaceAll(
+		instanceMethods,
+		"<SerializationMethods>",
+		serializationMethods,
+	)
 	return instanceMethods
 }
 
@@ -676,15 +1834,404 @@ func (v *generator_) generateInstanceTarget(
 	return target
 }
 
-func (v *generator_) generateModel(directory string, model ModelLike) {
+func (v *generator_) generateInstrumented(
+	directory string,
+	model ModelLike,
+	files col.CatalogLike[string, string],
+) {
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil {
+		return
+	}
+	var instances = interfaces.GetInstances()
+	if instances == nil {
+		return
+	}
+	var wrappers string
+	var locals = col.Set[string]().Make()
+	var iterator = instances.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var instanceInterface = iterator.GetNext()
+		wrappers += v.generateInstrumentedWrapper(model, instanceInterface, locals)
+	}
+	var instrumented = instrumentedTemplate_
+	var notice = model.GetNotice().GetComment()
+	instrumented = sts.ReplaceAll(instrumented, "<Notice>", notice)
+	var header = v.generateHeader(model)
+	instrumented = sts.ReplaceAll(instrumented, "<Header>", header)
+	instrumented = sts.ReplaceAll(instrumented, "<Wrappers>", wrappers)
+	var imports = v.generateImports(model, instrumented)
+	instrumented = sts.ReplaceAll(instrumented, "<Imports>", imports)
+	files.SetValue("instrumented.go", instrumented)
+}
+
+func (v *generator_) generateInstrumentedMethod(method MethodLike) string {
+	var formatter = Formatter().Make()
+	var methodName = method.GetIdentifier()
+	var parameters string
+	var argumentNames string
+	var argumentValues string
+	var methodParameters = method.GetParameters()
+	if methodParameters != nil {
+		parameters = formatter.FormatParameters(methodParameters)
+		var iterator = methodParameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var parameter = iterator.GetNext()
+			if len(argumentNames) > 0 {
+				argumentNames += ", "
+				argumentValues += ", "
+			}
+			argumentNames += parameter.GetIdentifier()
+			argumentValues += parameter.GetIdentifier()
+			if parameter.IsVariadic() {
+				argumentNames += "..."
+			}
+		}
+	}
+
+	// The results are named so that they can be reported once the call returns
+	// or panics.
+	var resultType string
+	var resultValues string
+	var result = method.GetResult()
+	var body = instrumentedCallBodyTemplate_
+	if result != nil {
+		var abstraction = result.GetAbstraction()
+		if abstraction != nil {
+			resultValues = "result_"
+			resultType = " (result_ " + formatter.FormatAbstraction(abstraction) + ")"
+		} else {
+			var resultParameters = result.GetParameters()
+			resultValues = formatter.FormatParameterNames(resultParameters)
+			resultType = " " + formatter.FormatResult(result)
+		}
+		body = instrumentedReturnBodyTemplate_
+	}
+	var instrumentedMethod = instrumentedMethodTemplate_
+	instrumentedMethod = sts.ReplaceAll(instrumentedMethod, "<Body>", body)
+	instrumentedMethod = sts.ReplaceAll(instrumentedMethod, "<MethodName>", methodName)
+	instrumentedMethod = sts.ReplaceAll(instrumentedMethod, "<Parameters>", parameters)
+	instrumentedMethod = sts.ReplaceAll(instrumentedMethod, "<ArgumentNames>", argumentNames)
+	instrumentedMethod = sts.ReplaceAll(instrumentedMethod, "<ArgumentValues>", argumentValues)
+	instrumentedMethod = sts.ReplaceAll(instrumentedMethod, "<ResultValues>", resultValues)
+	instrumentedMethod = sts.ReplaceAll(instrumentedMethod, "<ResultType>", resultType)
+	return instrumentedMethod
+}
+
+func (v *generator_) generateInstrumentedWrapper(
+	model ModelLike,
+	instanceInterface InstanceLike,
+	locals col.SetLike[string],
+) string {
+	var formatter = Formatter().Make()
+
+	// Every method, including those of any imported aspects, is forwarded to
+	// the delegate and observed.
+	var methods string
+	var alias string // The wrappers are part of the same package.
+	var sequence = v.extractMethods(model, instanceInterface, alias, locals)
+	var iterator = sequence.GetIterator()
+	for iterator.HasNext() {
+		var method = iterator.GetNext()
+		methods += v.generateInstrumentedMethod(method)
+	}
+
+	var wrapper = instrumentedWrapperTemplate_
+	wrapper = sts.ReplaceAll(wrapper, "<Methods>", methods)
+	var declaration = instanceInterface.GetDeclaration()
+	var className = sts.TrimSuffix(declaration.GetIdentifier(), "Like")
+	wrapper = sts.ReplaceAll(wrapper, "<ClassName>", className)
+	var parameters string
+	var arguments string
+	var declarationParameters = declaration.GetParameters()
+	if declarationParameters != nil {
+		parameters = "[" + formatter.FormatParameters(declarationParameters) + "]"
+		arguments = "[" + formatter.FormatParameterNames(declarationParameters) + "]"
+	}
+	wrapper = sts.ReplaceAll(wrapper, "[<Parameters>]", parameters)
+	wrapper = sts.ReplaceAll(wrapper, "[<Arguments>]", arguments)
+	return wrapper
+}
+
+func (v *generator_) generateMock(
+	directory string,
+	model ModelLike,
+	importPath string,
+	declaration DeclarationLike,
+	methods col.Sequential[MethodLike],
+	files col.CatalogLike[string, string],
+) {
+	var formatter = Formatter().Make()
+	var alias = model.GetHeader().GetIdentifier()
+	var locals = v.extractLocalNames(model)
+	var identifier = declaration.GetIdentifier()
+	var mockName = sts.TrimSuffix(identifier, "Like")
+	var mock = mockClassTemplate_
+
+	var notice = model.GetNotice().GetComment()
+	mock = sts.ReplaceAll(mock, "<Notice>", notice)
+	var header = headerTemplate_
+	header = sts.ReplaceAll(header, "<PackageName>", "mocks") + "\n"
+	mock = sts.ReplaceAll(mock, "<Header>", header)
+
+	var mockMethods string
+	var methodIterator = methods.GetIterator()
+	for methodIterator.HasNext() {
+		var method = methodIterator.GetNext()
+		mockMethods += v.generateMockMethod(method)
+	}
+	mock = sts.ReplaceAll(mock, "<Methods>", mockMethods)
+
+	var interfaceName = alias + "." + identifier
+	var parameters string
+	var arguments string
+	var declarationParameters = declaration.GetParameters()
+	if declarationParameters != nil {
+		declarationParameters = v.qualifyParameters(alias, locals, declarationParameters)
+		arguments = "[" + formatter.FormatParameterNames(declarationParameters) + "]"
+		parameters = "[" + formatter.FormatParameters(declarationParameters) + "]"
+		interfaceName += arguments
+	}
+	mock = sts.ReplaceAll(mock, "<InterfaceName>", interfaceName)
+	mock = sts.ReplaceAll(mock, "<MockName>", mockName)
+	mock = sts.ReplaceAll(mock, "[<Parameters>]", parameters)
+	mock = sts.ReplaceAll(mock, "[<Arguments>]", arguments)
+
+	var imports = v.generateMockImports(model, importPath, mock)
+	mock = sts.ReplaceAll(mock, "<Imports>", imports)
+
+	var fileName = "mocks/" + sts.ToLower(mockName) + ".go"
+	files.SetValue(fileName, mock)
+}
+
+func (v *generator_) generateMockImports(
+	model ModelLike,
+	importPath string,
+	mock string,
+) string {
+	var selectors = v.extractSelectors(model, mock)
+	var catalog = col.Catalog[string, string]().Make()
+	var alias = model.GetHeader().GetIdentifier()
+	if selectors.ContainsValue(alias) {
+		catalog.SetValue("\""+importPath+"\"", alias)
+	}
+	v.extractModules(model, selectors, catalog)
+	var imports string
+	if catalog.IsEmpty() {
+		return imports
+	}
+	var modules = v.generateModules(catalog)
+	imports = importsTemplate_
+	imports = sts.ReplaceAll(imports, "<Modules>", modules+"\n") + "\n"
+	return imports
+}
+
+func (v *generator_) generateMockMethod(method MethodLike) string {
+	var formatter = Formatter().Make()
+	var methodName = method.GetIdentifier()
+	var parameters string
+	var argumentNames string
+	var methodParameters = method.GetParameters()
+	if methodParameters != nil {
+		parameters = formatter.FormatParameters(methodParameters)
+		var iterator = methodParameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var parameter = iterator.GetNext()
+			argumentNames += ", " + parameter.GetIdentifier()
+		}
+	}
+	var resultType string
+	var body = mockCallBodyTemplate_
+	var result = method.GetResult()
+	if result != nil {
+		resultType = " " + formatter.FormatResult(result)
+		var abstraction = result.GetAbstraction()
+		if abstraction != nil {
+			body = mockResultBodyTemplate_
+			var abstractionType = formatter.FormatAbstraction(abstraction)
+			body = sts.ReplaceAll(body, "<ResultType>", abstractionType)
+		} else {
+			body = mockReturnBodyTemplate_
+			var results string
+			var index int
+			var iterator = result.GetParameters().GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var parameter = iterator.GetNext()
+				var abstraction = parameter.GetAbstraction()
+				var parameterType = formatter.FormatAbstraction(abstraction)
+				var mockResult = mockResultTemplate_
+				mockResult = sts.ReplaceAll(mockResult, "<Index>", fmt.Sprint(index))
+				mockResult = sts.ReplaceAll(mockResult, "<ResultName>", parameter.GetIdentifier())
+				mockResult = sts.ReplaceAll(mockResult, "<ResultType>", parameterType)
+				results += mockResult
+				index++
+			}
+			body = sts.ReplaceAll(body, "<Results>", results)
+		}
+	}
+	body = sts.ReplaceAll(body, "<ArgumentNames>", argumentNames)
+	var mockMethod = mockMethodTemplate_
+	mockMethod = sts.ReplaceAll(mockMethod, "<Body>", body)
+	mockMethod = sts.ReplaceAll(mockMethod, "<MethodName>", methodName)
+	mockMethod = sts.ReplaceAll(mockMethod, "<Parameters>", parameters)
+	mockMethod = sts.ReplaceAll(mockMethod, "<ResultType>", resultType)
+	return mockMethod
+}
+
+func (v *generator_) generateMocks(
+	directory string,
+	model ModelLike,
+	files col.CatalogLike[string, string],
+) {
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil {
+		return
+	}
+	var importPath = v.retrieveImportPath(directory)
+	var alias = model.GetHeader().GetIdentifier()
+	var locals = v.extractLocalNames(model)
+
+	// Generate the mock that is shared by all other mocks.
+	var notice = model.GetNotice().GetComment()
+	var mock = sts.ReplaceAll(mockTemplate_, "<Notice>", notice)
+	files.SetValue("mocks/mock.go", mock)
+
+	// Generate a mock for each aspect interface.
+	var aspects = interfaces.GetAspects()
+	if aspects != nil {
+		var iterator = aspects.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var aspect = iterator.GetNext()
+			var methods = col.List[MethodLike]().Make()
+			var aspectMethods = aspect.GetMethods()
+			if aspectMethods != nil {
+				var methodIterator = aspectMethods.GetSequence().GetIterator()
+				for methodIterator.HasNext() {
+					var method = methodIterator.GetNext()
+					methods.AppendValue(v.qualifyMethod(alias, locals, method))
+				}
+			}
+			v.generateMock(
+				directory,
+				model,
+				importPath,
+				aspect.GetDeclaration(),
+				methods,
+				files,
+			)
+		}
+	}
+
+	// Generate a mock for each instance interface.
+	var instances = interfaces.GetInstances()
+	if instances != nil {
+		var iterator = instances.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var instance = iterator.GetNext()
+			// The methods include those of any imported aspects so the mock
+			// records their calls as well.
+			var methods = v.extractMethods(model, instance, alias, locals)
+			v.generateMock(
+				directory,
+				model,
+				importPath,
+				instance.GetDeclaration(),
+				methods,
+				files,
+			)
+		}
+	}
+}
+
+func (v *generator_) generateModel(model ModelLike) string {
 	var formatter = Formatter().Make()
 	var source = formatter.FormatModel(model)
-	var bytes = []byte(source)
-	var modelFile = directory + "Package.go"
-	var err = osx.WriteFile(modelFile, bytes, 0644)
-	if err != nil {
-		panic(err)
+	return source
+}
+
+func (v *generator_) generateModules(
+	catalog col.CatalogLike[string, string],
+) string {
+	// Order the modules by their paths the same way that gofmt does.
+	var modules string
+	catalog.SortValues()
+	var iterator = catalog.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		modules += "\n\t" + association.GetValue() + " " + association.GetKey()
+	}
+	return modules
+}
+
+func (v *generator_) generateNilChecks(
+	model ModelLike,
+	constructor ConstructorLike,
+) string {
+	var checks string
+	for _, parameterName := range v.extractNilChecks(model, constructor) {
+		var check = nilCheckTemplate_
+		check = sts.ReplaceAll(check, "<ParameterName>", parameterName)
+		checks += check
+	}
+	return checks
+}
+
+func (v *generator_) generatePackage(directory string) {
+	var model = v.parseModel(directory)
+	if model == nil {
+		return
+	}
+
+	// Nothing needs to be generated if neither the model nor the configuration
+	// of the generator has changed since the package was last generated.
+	var stamps = v.readStamps(directory)
+	var hash = v.hashModel(model)
+	if stamps.GetValue("Package.go") == hash && v.isIntact(directory, stamps) {
+		return
+	}
+
+	// All of the files are generated in memory first.
+	var classes = col.Catalog[string, string]().Make()
+	v.generateClasses(directory, model, classes)
+	var generated = col.Catalog[string, string]().Make()
+	if v.options_.ContainsValue(MocksOption) {
+		v.generateMocks(directory, model, generated)
+	}
+	if v.options_.ContainsValue(SynchronizedOption) {
+		v.generateSynchronized(directory, model, generated)
+	}
+	if v.options_.ContainsValue(SerializationOption) {
+		v.generateSerialization(directory, model, generated)
+	}
+	if v.options_.ContainsValue(InstrumentedOption) {
+		v.generateInstrumented(directory, model, generated)
+	}
+
+	// Only the files that must be (re)written are kept.
+	var files = col.Catalog[string, string]().Make()
+	files.SetValue("Package.go", v.generateModel(model))
+	var iterator = classes.GetIterator()
+	for iterator.HasNext() {
+		var class = iterator.GetNext()
+		v.outputClass(directory, class.GetKey(), class.GetValue(), stamps, files)
+	}
+	iterator = generated.GetIterator()
+	for iterator.HasNext() {
+		var file = iterator.GetNext()
+		v.outputGenerated(directory, file.GetKey(), file.GetValue(), stamps, files)
 	}
+
+	// Make sure that the package type checks against the model before any of
+	// the files are written so that a failure leaves the package untouched.
+	if !v.options_.ContainsValue(UncheckedOption) {
+		v.checkPackages(directory, files)
+	}
+
+	// The stamps are written even if writing the files is interrupted so that
+	// the files that were written are still recognized as generated.
+	defer v.writeStamps(directory, stamps)
+	v.writeFiles(directory, files, stamps)
+	stamps.SetValue("Package.go", hash)
 }
 
 func (v *generator_) generatePublicMethods(instanceInterface InstanceLike) string {
@@ -719,31 +2266,601 @@ func (v *generator_) generatePublicMethods(instanceInterface InstanceLike) strin
 		method = sts.ReplaceAll(method, "<MethodName>", methodName)
 		method = sts.ReplaceAll(method, "<Parameters>", parameters)
 		method = sts.ReplaceAll(method, "<ResultType>", resultType)
+		var comment = publicMethod.GetComment()
+		method = sts.ReplaceAll(method, "<Comment>", comment)
 		publicMethods += method + "\n"
 	}
 	return publicMethods
 }
 
-func (v *generator_) makePrivate(identifier string) string {
-	runes := []rune(identifier)
-	runes[0] = uni.ToLower(runes[0])
-	return string(runes)
-}
-
-func (v *generator_) outputClass(classFile, class string) {
-	var _, err = osx.ReadFile(classFile)
-	if err == nil {
-		// Don't overwrite an existing class file.
-		fmt.Printf(
-			"The class file %q already exists, leaving it alone.\n",
-			classFile,
-		)
-		return
-	}
-	err = osx.WriteFile(classFile, []byte(class), 0644)
-	if err != nil {
-		panic(err)
-	}
+func (v *generator_) generateSerialization(
+	directory string,
+	model ModelLike,
+	files col.CatalogLike[string, string],
+) {
+	// Each enumerated specialization is serialized using its value names.
+	var specializations string
+	var types = model.GetTypes()
+	if types != nil && types.GetSpecializations() != nil {
+		var iterator = types.GetSpecializations().GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var specialization = iterator.GetNext()
+			var declaration = specialization.GetDeclaration()
+			var enumeration = specialization.GetEnumeration()
+			if enumeration == nil || declaration.GetParameters() != nil {
+				continue
+			}
+			var values = enumeration.GetValues()
+			var names = []string{values.GetParameter().GetIdentifier()}
+			names = append(names, values.GetSequence().AsArray()...)
+			var nameCases string
+			var valueCases string
+			for _, name := range names {
+				nameCases += sts.ReplaceAll(nameCaseTemplate_, "<Value>", name)
+				valueCases += sts.ReplaceAll(valueCaseTemplate_, "<Value>", name)
+			}
+			var serialization = specializationSerializationTemplate_
+			serialization = sts.ReplaceAll(serialization, "<NameCases>", nameCases)
+			serialization = sts.ReplaceAll(serialization, "<ValueCases>", valueCases)
+			serialization = sts.ReplaceAll(
+				serialization,
+				"<SpecializationName>",
+				declaration.GetIdentifier(),
+			)
+			specializations += serialization
+		}
+	}
+
+	// The CDCN functions are only needed by the classes that are serialized.
+	var functions string
+	var interfaces = model.GetInterfaces()
+	if interfaces != nil && interfaces.GetClasses() != nil {
+		var iterator = interfaces.GetClasses().GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var classInterface = iterator.GetNext()
+			var identifier = classInterface.GetDeclaration().GetIdentifier()
+			identifier = sts.TrimSuffix(identifier, "ClassLike") + "Like"
+			var instanceInterface = v.retrieveInstance(model, identifier)
+			if instanceInterface == nil {
+				continue
+			}
+			var data = v.extractSerializationData(model, classInterface, instanceInterface)
+			if data != nil {
+				functions = notationFunctionsTemplate_
+				break
+			}
+		}
+	}
+	if len(specializations) == 0 && len(functions) == 0 {
+		return
+	}
+	var source = serializationTemplate_
+	var notice = model.GetNotice().GetComment()
+	source = sts.ReplaceAll(source, "<Notice>", notice)
+	var header = v.generateHeader(model)
+	source = sts.ReplaceAll(source, "<Header>", header)
+	source = sts.ReplaceAll(source, "<Specializations>", specializations)
+	source = sts.ReplaceAll(source, "<Functions>", functions)
+	var imports = v.generateImports(model, source)
+	source = sts.ReplaceAll(source, "<Imports>", imports)
+	files.SetValue("serialization.go", source)
+}
+
+func (v *generator_) generateSerializationMethods(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) string {
+	var serializationMethods string
+	var data = v.extractSerializationData(model, classInterface, instanceInterface)
+	if data == nil {
+		return serializationMethods
+	}
+	// Each attribute is encoded using both JSON and CDCN.
+	var encodings string
+	var decodings string
+	var notationEncodings string
+	var notationDecodings string
+	var parameterNames []string
+	for _, parameter := range data.Parameters {
+		var parameterName = parameter.Name
+		if parameter.IsVariadic {
+			parameterName += "..."
+		}
+		parameterNames = append(parameterNames, parameterName)
+		var replacer = sts.NewReplacer(
+			"<AttributeName>", parameter.Field,
+			"<ParameterName>", parameter.Name,
+			"<ParameterType>", parameter.Type,
+		)
+		encodings += replacer.Replace(attributeEncodingTemplate_)
+		decodings += replacer.Replace(attributeDecodingTemplate_)
+		notationEncodings += replacer.Replace(attributeNotationEncodingTemplate_)
+		notationDecodings += replacer.Replace(attributeNotationDecodingTemplate_)
+	}
+	var generated string
+	for _, methodName := range data.Methods {
+		var method string
+		switch methodName {
+		case "MarshalJSON":
+			method = sts.ReplaceAll(marshalMethodTemplate_, "<Encodings>", encodings)
+		case "UnmarshalJSON":
+			method = sts.ReplaceAll(unmarshalMethodTemplate_, "<Decodings>", decodings)
+		case "MarshalCDCN":
+			method = sts.ReplaceAll(marshalNotationTemplate_, "<Encodings>", notationEncodings)
+		case "UnmarshalCDCN":
+			method = sts.ReplaceAll(unmarshalNotationTemplate_, "<Decodings>", notationDecodings)
+		}
+		method = sts.ReplaceAll(method, "<ParameterNames>", sts.Join(parameterNames, ", "))
+		generated += method
+	}
+	serializationMethods = sts.ReplaceAll(
+		serializationMethodsTemplate_,
+		"<Methods>",
+		generated,
+	)
+	return serializationMethods
+}
+
+func (v *generator_) generateSynchronized(
+	directory string,
+	model ModelLike,
+	files col.CatalogLike[string, string],
+) {
+	var interfaces = model.GetInterfaces()
+	if interfaces == nil {
+		return
+	}
+	var instances = interfaces.GetInstances()
+	if instances == nil {
+		return
+	}
+	var wrappers string
+	var locals = col.Set[string]().Make()
+	var iterator = instances.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var instanceInterface = iterator.GetNext()
+		wrappers += v.generateSynchronizedWrapper(model, instanceInterface, locals)
+	}
+	var synchronized = synchronizedTemplate_
+	var notice = model.GetNotice().GetComment()
+	synchronized = sts.ReplaceAll(synchronized, "<Notice>", notice)
+	var header = v.generateHeader(model)
+	synchronized = sts.ReplaceAll(synchronized, "<Header>", header)
+	synchronized = sts.ReplaceAll(synchronized, "<Wrappers>", wrappers)
+	var imports = v.generateImports(model, synchronized)
+	synchronized = sts.ReplaceAll(synchronized, "<Imports>", imports)
+
+	files.SetValue("synchronized.go", synchronized)
+}
+
+func (v *generator_) generateSynchronizedMethod(
+	method MethodLike,
+	isReader bool,
+) string {
+	var formatter = Formatter().Make()
+	var methodName = method.GetIdentifier()
+	var parameters string
+	var argumentNames string
+	var methodParameters = method.GetParameters()
+	if methodParameters != nil {
+		parameters = formatter.FormatParameters(methodParameters)
+		var iterator = methodParameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var parameter = iterator.GetNext()
+			if len(argumentNames) > 0 {
+				argumentNames += ", "
+			}
+			argumentNames += parameter.GetIdentifier()
+			if parameter.IsVariadic() {
+				argumentNames += "..."
+			}
+		}
+	}
+	var resultType string
+	var result = method.GetResult()
+	var body = synchronizedCallBodyTemplate_
+	if result != nil {
+		resultType = " " + formatter.FormatResult(result)
+		body = synchronizedReturnBodyTemplate_
+	}
+	var lock = "Lock"
+	var unlock = "Unlock"
+	if isReader {
+		lock = "RLock"
+		unlock = "RUnlock"
+	}
+	var synchronizedMethod = synchronizedMethodTemplate_
+	synchronizedMethod = sts.ReplaceAll(synchronizedMethod, "<Body>", body)
+	synchronizedMethod = sts.ReplaceAll(synchronizedMethod, "<Lock>", lock)
+	synchronizedMethod = sts.ReplaceAll(synchronizedMethod, "<Unlock>", unlock)
+	synchronizedMethod = sts.ReplaceAll(synchronizedMethod, "<MethodName>", methodName)
+	synchronizedMethod = sts.ReplaceAll(synchronizedMethod, "<Parameters>", parameters)
+	synchronizedMethod = sts.ReplaceAll(synchronizedMethod, "<ArgumentNames>", argumentNames)
+	synchronizedMethod = sts.ReplaceAll(synchronizedMethod, "<ResultType>", resultType)
+	return synchronizedMethod
+}
+
+func (v *generator_) generateSynchronizedWrapper(
+	model ModelLike,
+	instanceInterface InstanceLike,
+	locals col.SetLike[string],
+) string {
+	var formatter = Formatter().Make()
+
+	// Only the getter attributes are guarded using a read lock.
+	var readers = col.Set[string]().Make()
+	v.extractReaders(model, instanceInterface, readers)
+
+	// Every method, including those of any imported aspects, is forwarded to
+	// the delegate while the mutex is held.
+	var methods string
+	var alias string // The wrappers are part of the same package.
+	var sequence = v.extractMethods(model, instanceInterface, alias, locals)
+	var iterator = sequence.GetIterator()
+	for iterator.HasNext() {
+		var method = iterator.GetNext()
+		var isReader = readers.ContainsValue(method.GetIdentifier())
+		methods += v.generateSynchronizedMethod(method, isReader)
+	}
+
+	var wrapper = synchronizedWrapperTemplate_
+	wrapper = sts.ReplaceAll(wrapper, "<Methods>", methods)
+	var declaration = instanceInterface.GetDeclaration()
+	var className = sts.TrimSuffix(declaration.GetIdentifier(), "Like")
+	wrapper = sts.ReplaceAll(wrapper, "<ClassName>", className)
+	var parameters string
+	var arguments string
+	var declarationParameters = declaration.GetParameters()
+	if declarationParameters != nil {
+		parameters = "[" + formatter.FormatParameters(declarationParameters) + "]"
+		arguments = "[" + formatter.FormatParameterNames(declarationParameters) + "]"
+	}
+	wrapper = sts.ReplaceAll(wrapper, "[<Parameters>]", parameters)
+	wrapper = sts.ReplaceAll(wrapper, "[<Arguments>]", arguments)
+	return wrapper
+}
+
+func (v *generator_) generateValueDifference(
+	left string,
+	right string,
+	isComparable bool,
+) string {
+	// Any values that are not comparable are compared deeply.
+	if isComparable {
+		return left + " != " + right
+	}
+	return "!ref.DeepEqual(" + left + ", " + right + ")"
+}
+
+func (v *generator_) generateValueMethods(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) string {
+	var valueMethods string
+	var data = v.extractValueData(model, classInterface, instanceInterface)
+	if data == nil {
+		return valueMethods
+	}
+	var comparisons string
+	var copies string
+	var strings string
+	var separator string
+	for _, field := range data.Fields {
+		var comparison string
+		var copy_ string
+		var string_ = valueStringTemplate_
+		switch field.Kind {
+		case "slice":
+			var different = v.generateValueDifference(
+				"value",
+				"that.<FieldName>[index]",
+				field.IsComparable,
+			)
+			comparison = sts.ReplaceAll(sliceComparisonTemplate_, "<Different>", different)
+			copy_ = sliceCopyTemplate_
+		case "map":
+			var different = v.generateValueDifference(
+				"value",
+				"thatValue",
+				field.IsComparable,
+			)
+			comparison = sts.ReplaceAll(mapComparisonTemplate_, "<Different>", different)
+			copy_ = mapCopyTemplate_
+		case "sequence":
+			var different = v.generateValueDifference(
+				"value",
+				"thatValues[index]",
+				field.IsComparable,
+			)
+			comparison = sts.ReplaceAll(sequenceComparisonTemplate_, "<Different>", different)
+			string_ = sequenceStringTemplate_
+			copy_ = sts.ReplaceAll(sequenceCopyTemplate_, "<Alias>", field.Alias)
+			copy_ = sts.ReplaceAll(copy_, "<ValueType>", field.ElementType)
+		case "functional":
+			comparison = functionalComparisonTemplate_
+		default:
+			var different = v.generateValueDifference(
+				"v.<FieldName>",
+				"that.<FieldName>",
+				field.IsComparable,
+			)
+			comparison = sts.ReplaceAll(valueComparisonTemplate_, "<Different>", different)
+		}
+		var replacer = sts.NewReplacer(
+			"<AttributeName>", field.Name,
+			"<FieldName>", field.Name+"_",
+			"<FieldType>", field.Type,
+			"<Separator>", separator,
+		)
+		comparisons += replacer.Replace(comparison)
+		copies += replacer.Replace(copy_)
+		strings += replacer.Replace(string_)
+		separator = ", "
+	}
+	var generated string
+	for _, methodName := range data.Methods {
+		switch methodName {
+		case "Equal":
+			generated += sts.ReplaceAll(equalMethodTemplate_, "<Comparisons>", comparisons)
+		case "Copy":
+			generated += sts.ReplaceAll(copyMethodTemplate_, "<Copies>", copies)
+		case "String":
+			generated += sts.ReplaceAll(stringMethodTemplate_, "<Strings>", strings)
+		}
+	}
+	valueMethods = sts.ReplaceAll(valueMethodsTemplate_, "<Methods>", generated)
+	return valueMethods
+}
+
+func (v *generator_) hashModel(model ModelLike) string {
+	// The generated package depends on the canonical form of the model and the
+	// version and configuration of the generator.
+	var formatter = Formatter().Make()
+	var source = formatter.FormatModel(model)
+	source += "\nversion " + generatorClass.retrieveVersion()
+	var iterator = v.options_.GetIterator()
+	for iterator.HasNext() {
+		source += fmt.Sprintf("\noption %v", iterator.GetNext())
+	}
+	if v.templates_ != nil {
+		for _, template := range v.templates_.Templates() {
+			if template.Tree != nil {
+				source += "\ntemplate " + template.Name() + "\n"
+				source += template.Tree.Root.String()
+			}
+		}
+	}
+	return v.hashSource(source)
+}
+
+func (v *generator_) hashSource(source string) string {
+	return fmt.Sprintf("%x", sha.Sum256([]byte(source)))
+}
+
+func (v *generator_) isComparable(valueType string) bool {
+	// Only values of the builtin types are known to be comparable using the
+	// inequality operator.
+	switch valueType {
+	case "bool", "byte", "complex64", "complex128", "float32", "float64",
+		"int", "int8", "int16", "int32", "int64", "rune", "string", "uint",
+		"uint8", "uint16", "uint32", "uint64", "uintptr":
+		return true
+	default:
+		return false
+	}
+}
+
+func (v *generator_) isFunctional(model ModelLike, attributeType string) bool {
+	// The types defined in other modules are unknown.
+	var identifier = attributeType
+	var index = sts.Index(identifier, "[")
+	if index >= 0 {
+		identifier = identifier[:index]
+	}
+	var types = model.GetTypes()
+	if sts.Contains(identifier, ".") || types == nil || types.GetFunctionals() == nil {
+		return false
+	}
+	var iterator = types.GetFunctionals().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var functional = iterator.GetNext()
+		if functional.GetDeclaration().GetIdentifier() == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *generator_) isIntact(
+	directory string,
+	stamps col.CatalogLike[string, string],
+) bool {
+	// Make sure that none of the generated files have been removed.
+	var iterator = stamps.GetIterator()
+	for iterator.HasNext() {
+		var fileName = iterator.GetNext().GetKey()
+		var _, err = osx.Stat(directory + fileName)
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *generator_) isNillable(
+	model ModelLike,
+	abstraction AbstractionLike,
+) bool {
+	// Only the parameters with an interface type can be checked for nil.
+	var identifier = abstraction.GetIdentifier()
+	var prefix = abstraction.GetPrefix()
+	if prefix != nil {
+		if prefix.GetType() != AliasPrefix {
+			return false
+		}
+		var alias = prefix.GetIdentifier()
+		var path = v.retrieveModulePath(model, alias)
+		var package_, err = v.importer_.Import(path)
+		if err != nil {
+			var message = fmt.Sprintf(
+				"The module of the imported type %v.%v could not be loaded: %v",
+				alias,
+				identifier,
+				err,
+			)
+			panic(message)
+		}
+		var object, _ = package_.Scope().Lookup(identifier).(*typ.TypeName)
+		return object != nil && typ.IsInterface(object.Type())
+	}
+	switch {
+	case identifier == "any" || identifier == "error":
+		return true
+	case v.retrieveAspect(model, identifier) != nil:
+		return true
+	case v.retrieveClass(model, identifier) != nil:
+		return true
+	case v.retrieveInstance(model, identifier) != nil:
+		return true
+	}
+
+	// A specialization is nillable if the type that it specializes is.
+	var specialization = v.retrieveSpecialization(model, identifier)
+	if specialization != nil {
+		return v.isNillable(model, specialization.GetAbstraction())
+	}
+	return false
+}
+
+func (v *generator_) isSequential(attributeType string) bool {
+	// The sequential type may be qualified by a module alias.
+	var identifier = attributeType
+	var index = sts.Index(identifier, "[")
+	if index < 0 {
+		return false
+	}
+	identifier = identifier[:index]
+	identifier = identifier[sts.LastIndex(identifier, ".")+1:]
+	return identifier == "Sequential"
+}
+
+func (v *generator_) isSerializable(
+	model ModelLike,
+	typeParameters col.SetLike[string],
+	abstraction AbstractionLike,
+) bool {
+	// The types defined in other modules are unknown and only builtin types
+	// may be used as map keys.
+	var prefix = abstraction.GetPrefix()
+	if prefix != nil {
+		switch prefix.GetType() {
+		case ArrayPrefix:
+			// The values of the slice are checked below.
+		case MapPrefix:
+			var key = prefix.GetIdentifier()
+			if v.extractLocalNames(model).ContainsValue(key) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	// Interfaces and functions cannot be deserialized.
+	var identifier = abstraction.GetIdentifier()
+	switch {
+	case typeParameters.ContainsValue(identifier):
+		return true
+	case v.retrieveSpecialization(model, identifier) != nil:
+		return true
+	case identifier == "any" || identifier == "error":
+		return false
+	default:
+		return !v.extractLocalNames(model).ContainsValue(identifier)
+	}
+}
+
+func (v *generator_) makePrivate(identifier string) string {
+	runes := []rune(identifier)
+	runes[0] = uni.ToLower(runes[0])
+	return string(runes)
+}
+
+func (v *generator_) outputClass(
+	directory string,
+	fileName string,
+	class string,
+	stamps col.CatalogLike[string, string],
+	files col.CatalogLike[string, string],
+) {
+	var classFile = directory + fileName
+	var hash = v.hashSource(class)
+	var stamp = stamps.GetValue(fileName)
+	var bytes, err = osx.ReadFile(classFile)
+	if err == nil {
+		switch {
+		case hash == stamp:
+			// The class has not changed since it was last generated.
+			return
+		case v.hashSource(string(bytes)) == hash:
+			// The class was generated but its stamp was lost.
+			stamps.SetValue(fileName, hash)
+			return
+		case len(stamp) == 0:
+			// Don't overwrite a class file that was not generated.
+			fmt.Printf(
+				"The class file %q already exists, leaving it alone.\n",
+				classFile,
+			)
+			return
+		case v.hashSource(string(bytes)) != stamp:
+			// Don't overwrite a class file that was edited by hand.
+			fmt.Printf(
+				"The class file %q was edited since it was generated, leaving it alone.\n",
+				classFile,
+			)
+			return
+		}
+	}
+	files.SetValue(fileName, class)
+}
+
+func (v *generator_) outputGenerated(
+	directory string,
+	fileName string,
+	source string,
+	stamps col.CatalogLike[string, string],
+	files col.CatalogLike[string, string],
+) {
+	// Generated files other than class files are regenerated whenever their
+	// source changes, unless that would lose any edits made to them by hand.
+	var generatedFile = directory + fileName
+	source = v.formatSource(generatedFile, source)
+	var hash = v.hashSource(source)
+	var stamp = stamps.GetValue(fileName)
+	var bytes, err = osx.ReadFile(generatedFile)
+	if err == nil {
+		var existing = v.hashSource(string(bytes))
+		switch {
+		case existing == hash:
+			// The generated file is already up to date.
+			stamps.SetValue(fileName, hash)
+			return
+		case hash == stamp:
+			// The source has not changed since the file was last generated.
+			return
+		case len(stamp) > 0 && existing != stamp:
+			// Don't overwrite a generated file that was edited by hand.
+			var message = fmt.Sprintf(
+				"The generated file %q was edited since it was generated, remove it to regenerate it.",
+				generatedFile,
+			)
+			panic(message)
+		}
+	}
+	files.SetValue(fileName, source)
 }
 
 func (v *generator_) parseModel(directory string) ModelLike {
@@ -764,97 +2881,156 @@ func (v *generator_) parseModel(directory string) ModelLike {
 	return model
 }
 
-func (v *generator_) replaceGenericType(
-	genericTypes ParametersLike,
-	concreteTypes ArgumentsLike,
+func (v *generator_) qualifyAbstraction(
+	alias string,
+	locals col.SetLike[string],
 	abstraction AbstractionLike,
 ) AbstractionLike {
-	var formatter = Formatter().Make()
 	var prefix = abstraction.GetPrefix()
 	var identifier = abstraction.GetIdentifier()
 	var arguments = abstraction.GetArguments()
-	var genericIterator = genericTypes.GetSequence().GetIterator()
-	var concreteIterator = concreteTypes.GetSequence().GetIterator()
-	for genericIterator.HasNext() {
-		var genericType = genericIterator.GetNext()
-		var genericName = genericType.GetIdentifier()
-		var concreteType = concreteIterator.GetNext()
-		if identifier == genericName {
-			identifier = formatter.FormatAbstraction(concreteType)
-			break
+	var isQualified bool
+	if prefix != nil {
+		switch prefix.GetType() {
+		case AliasPrefix:
+			// The identifier is already qualified by its module alias.
+			isQualified = true
+		case MapPrefix:
+			var key = prefix.GetIdentifier()
+			if locals.ContainsValue(key) {
+				prefix = Prefix().MakeWithAttributes(alias+"."+key, MapPrefix)
+			}
 		}
 	}
-	var sequence = col.List[AbstractionLike]().Make()
+	if !isQualified && locals.ContainsValue(identifier) {
+		identifier = alias + "." + identifier
+	}
 	if arguments != nil {
-		var argumentIterator = arguments.GetSequence().GetIterator()
-		for argumentIterator.HasNext() {
-			var argument = argumentIterator.GetNext()
-			argument = v.replaceGenericType(
-				genericTypes,
-				concreteTypes,
-				argument,
-			)
-			sequence.AppendValue(argument)
-		}
-		arguments = Abstractions().MakeWithAttributes(sequence)
+		arguments = v.qualifyArguments(alias, locals, arguments)
 	}
 	abstraction = Abstraction().MakeWithAttributes(prefix, identifier, arguments)
 	return abstraction
 }
 
-func (v *generator_) replaceParameterTypes(
-	genericTypes ParametersLike,
-	concreteTypes ArgumentsLike,
-	methodParameters ParametersLike,
+func (v *generator_) qualifyArguments(
+	alias string,
+	locals col.SetLike[string],
+	arguments ArgumentsLike,
+) ArgumentsLike {
+	var sequence = col.List[AbstractionLike]().Make()
+	var iterator = arguments.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var argument = iterator.GetNext()
+		argument = v.qualifyAbstraction(alias, locals, argument)
+		sequence.AppendValue(argument)
+	}
+	arguments = Arguments().MakeWithAttributes(sequence)
+	return arguments
+}
+
+func (v *generator_) qualifyMethod(
+	alias string,
+	locals col.SetLike[string],
+	method MethodLike,
+) MethodLike {
+	if len(alias) == 0 {
+		return method
+	}
+	var parameters = method.GetParameters()
+	if parameters != nil {
+		parameters = v.qualifyParameters(alias, locals, parameters)
+	}
+	var result = method.GetResult()
+	if result != nil {
+		var abstraction = result.GetAbstraction()
+		if abstraction != nil {
+			abstraction = v.qualifyAbstraction(alias, locals, abstraction)
+			result = Result().MakeWithAbstraction(abstraction)
+		} else {
+			var resultParameters = result.GetParameters()
+			resultParameters = v.qualifyParameters(alias, locals, resultParameters)
+			result = Result().MakeWithParameters(resultParameters)
+		}
+	}
+	method = Method().MakeWithAttributes(
+		method.GetComment(),
+		method.GetIdentifier(),
+		parameters,
+		result,
+	)
+	return method
+}
+
+func (v *generator_) qualifyParameters(
+	alias string,
+	locals col.SetLike[string],
+	parameters ParametersLike,
 ) ParametersLike {
 	var sequence = col.List[ParameterLike]().Make()
-	var parameterIterator = methodParameters.GetSequence().GetIterator()
-	for parameterIterator.HasNext() {
-		var methodParameter = parameterIterator.GetNext()
-		var parameterName = methodParameter.GetIdentifier()
-		var parameterType = methodParameter.GetAbstraction()
-		parameterType = v.replaceGenericType(
-			genericTypes,
-			concreteTypes,
-			parameterType,
-		)
-		methodParameter = Parameter().MakeWithAttributes(parameterName, parameterType)
-		sequence.AppendValue(methodParameter)
-	}
-	methodParameters = Parameters().MakeWithAttributes(sequence)
-	return methodParameters
-}
-
-func (v *generator_) replaceResultTypes(
-	genericTypes ParametersLike,
-	concreteTypes ArgumentsLike,
-	methodResult ResultLike,
-) ResultLike {
-	var resultAbstraction = methodResult.GetAbstraction()
-	if resultAbstraction != nil {
-		resultAbstraction = v.replaceGenericType(
-			genericTypes,
-			concreteTypes,
-			resultAbstraction,
-		)
-		methodResult = Result().MakeWithAbstraction(resultAbstraction)
-	} else {
-		var resultParameters = methodResult.GetParameters()
-		resultParameters = v.replaceParameterTypes(
-			genericTypes,
-			concreteTypes,
-			resultParameters,
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		var abstraction = parameter.GetAbstraction()
+		abstraction = v.qualifyAbstraction(alias, locals, abstraction)
+		parameter = Parameter().MakeWithAttributes(
+			parameter.GetIdentifier(),
+			parameter.IsVariadic(),
+			abstraction,
 		)
-		methodResult = Result().MakeWithParameters(resultParameters)
+		sequence.AppendValue(parameter)
+	}
+	parameters = Parameters().MakeWithAttributes(sequence)
+	return parameters
+}
+
+func (v *generator_) readStamps(
+	directory string,
+) col.CatalogLike[string, string] {
+	// Each line of the stamp file contains the hash of a generated file (or of
+	// the model) followed by its name.
+	var stamps = col.Catalog[string, string]().Make()
+	var bytes, err = osx.ReadFile(directory + "Package.stamp")
+	if err != nil {
+		// The package has not been generated before.
+		return stamps
+	}
+	var lines = sts.Split(string(bytes), "\n")
+	for _, line := range lines {
+		var fields = sts.Fields(line)
+		if len(fields) != 2 || sts.HasPrefix(line, "#") {
+			continue
+		}
+		stamps.SetValue(fields[1], fields[0])
+	}
+	return stamps
+}
+
+func (v *generator_) renderClass(
+	model ModelLike,
+	classInterface ClassLike,
+	instanceInterface InstanceLike,
+) string {
+	var data = v.extractClassData(model, classInterface, instanceInterface)
+	var builder sts.Builder
+	var err = v.templates_.ExecuteTemplate(&builder, "class.tmpl", data)
+	if err != nil {
+		panic(err)
 	}
-	return methodResult
+	var class = builder.String()
+	var imports = v.generateImports(model, class)
+	class = sts.ReplaceAll(class, "<Imports>", imports)
+	return class
 }
 
 func (v *generator_) retrieveAspect(
 	model ModelLike,
 	identifier string,
 ) AspectLike {
-	var iterator = model.GetInterfaces().GetAspects().GetSequence().GetIterator()
+	var aspects = model.GetInterfaces().GetAspects()
+	if aspects == nil {
+		return nil
+	}
+	var iterator = aspects.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var aspect = iterator.GetNext()
 		var declaration = aspect.GetDeclaration()
@@ -862,9 +3038,296 @@ func (v *generator_) retrieveAspect(
 			return aspect
 		}
 	}
+	return nil
+}
+
+func (v *generator_) retrieveClass(
+	model ModelLike,
+	identifier string,
+) ClassLike {
+	var classes = model.GetInterfaces().GetClasses()
+	if classes == nil {
+		return nil
+	}
+	var iterator = classes.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var class = iterator.GetNext()
+		var declaration = class.GetDeclaration()
+		if declaration.GetIdentifier() == identifier {
+			return class
+		}
+	}
+	return nil
+}
+
+func (v *generator_) retrieveConstructor(
+	classInterface ClassLike,
+	identifier string,
+) ConstructorLike {
+	var constructors = classInterface.GetConstructors()
+	if constructors == nil {
+		return nil
+	}
+	var iterator = constructors.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var constructor = iterator.GetNext()
+		if constructor.GetIdentifier() == identifier {
+			return constructor
+		}
+	}
+	return nil
+}
+
+func (v *generator_) retrieveImportedAspect(
+	model ModelLike,
+	abstraction AbstractionLike,
+) (DeclarationLike, col.Sequential[MethodLike]) {
+	var alias = abstraction.GetPrefix().GetIdentifier()
+	var identifier = abstraction.GetIdentifier()
+	var path = v.retrieveModulePath(model, alias)
+	var package_, err = v.importer_.Import(path)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The module of the imported aspect %v.%v could not be loaded: %v",
+			alias,
+			identifier,
+			err,
+		)
+		panic(message)
+	}
+	var object, _ = package_.Scope().Lookup(identifier).(*typ.TypeName)
+	var named *typ.Named
+	var aspect *typ.Interface
+	if object != nil && object.Exported() {
+		named, _ = object.Type().(*typ.Named)
+	}
+	if named != nil {
+		aspect, _ = named.Underlying().(*typ.Interface)
+	}
+	if aspect == nil {
+		var message = fmt.Sprintf(
+			"The imported aspect %v.%v is not an exported interface.",
+			alias,
+			identifier,
+		)
+		panic(message)
+	}
+
+	// The type parameters only need their names to be substituted.
+	var parameters ParametersLike
+	var typeParameters = named.TypeParams()
+	if typeParameters.Len() > 0 {
+		var sequence = col.List[ParameterLike]().Make()
+		for index := range typeParameters.Len() {
+			var name = typeParameters.At(index).Obj().Name()
+			var constraint = Abstraction().MakeWithAttributes(nil, "any", nil)
+			sequence.AppendValue(Parameter().MakeWithAttributes(name, false, constraint))
+		}
+		parameters = Parameters().MakeWithAttributes(sequence)
+	}
+	var declaration = Declaration().MakeWithAttributes("", identifier, parameters)
+	var methods = col.List[MethodLike]().Make()
+	for index := range aspect.NumMethods() {
+		var function = aspect.Method(index)
+		if function.Exported() {
+			methods.AppendValue(v.convertMethod(model, function))
+		}
+	}
+	return declaration, methods
+}
+
+func (v *generator_) retrieveImportPath(directory string) string {
+	var path, err = pfp.Abs(directory)
+	if err != nil {
+		panic(err)
+	}
+	var packagePath string
+	for {
+		var bytes, err = osx.ReadFile(pfp.Join(path, "go.mod"))
+		if err == nil {
+			var matcher = reg.MustCompile(`(?m)^module[ \t]+(\S+)`)
+			var matches = matcher.FindStringSubmatch(string(bytes))
+			if len(matches) > 1 {
+				return matches[1] + packagePath
+			}
+		}
+		var parent = pfp.Dir(path)
+		if parent == path {
+			var message = fmt.Sprintf(
+				"The specified directory is not part of a Go module: %v",
+				directory,
+			)
+			panic(message)
+		}
+		packagePath = "/" + pfp.Base(path) + packagePath
+		path = parent
+	}
+}
+
+func (v *generator_) retrieveInstance(
+	model ModelLike,
+	identifier string,
+) InstanceLike {
+	var instances = model.GetInterfaces().GetInstances()
+	if instances == nil {
+		return nil
+	}
+	var iterator = instances.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var instance = iterator.GetNext()
+		var declaration = instance.GetDeclaration()
+		if declaration.GetIdentifier() == identifier {
+			return instance
+		}
+	}
+	return nil
+}
+
+func (v *generator_) retrieveMethods(
+	model ModelLike,
+	abstraction AbstractionLike,
+	alias string,
+	locals col.SetLike[string],
+) col.Sequential[MethodLike] {
+	// An abstraction may name an imported aspect or a local aspect, class
+	// interface or instance interface, whose inherited members become methods.
+	var identifier = abstraction.GetIdentifier()
+	var declaration DeclarationLike
+	var methods col.Sequential[MethodLike]
+	var aspect = v.retrieveAspect(model, identifier)
+	var class = v.retrieveClass(model, identifier)
+	var instance = v.retrieveInstance(model, identifier)
+	switch {
+	case abstraction.GetPrefix() != nil:
+		// An imported aspect is resolved from the source of its module.
+		declaration, methods = v.retrieveImportedAspect(model, abstraction)
+	case aspect != nil:
+		declaration = aspect.GetDeclaration()
+		methods = col.List[MethodLike]().Make()
+		if aspect.GetMethods() != nil {
+			methods = aspect.GetMethods().GetSequence()
+		}
+	case class != nil:
+		declaration = class.GetDeclaration()
+		methods = v.extractClassMethods(class)
+	case instance != nil:
+		declaration = instance.GetDeclaration()
+		methods = v.extractMethods(model, instance, alias, locals)
+	default:
+		var message = fmt.Sprintf(
+			"Missing the following abstraction definition: %v",
+			identifier,
+		)
+		panic(message)
+	}
+	var genericTypes = declaration.GetParameters()
+	var concreteTypes = abstraction.GetArguments()
+	if concreteTypes != nil && len(alias) > 0 {
+		concreteTypes = v.qualifyArguments(alias, locals, concreteTypes)
+	}
+	var substitutor = Substitutor().MakeWithTypes(genericTypes, concreteTypes)
+	var sequence = col.List[MethodLike]().Make()
+	var iterator = methods.GetIterator()
+	for iterator.HasNext() {
+		var method = v.qualifyMethod(alias, locals, iterator.GetNext())
+
+		// Replace the generic type names from the abstraction definition with
+		// the actual types defined in the instance interface.
+		method = substitutor.SubstituteMethod(method)
+		sequence.AppendValue(method)
+	}
+	return sequence
+}
+
+func (v *generator_) retrieveModuleAlias(model ModelLike, path string) string {
+	var alias string
+	var imports = model.GetImports()
+	if imports == nil || imports.GetModules() == nil {
+		return alias
+	}
+	var iterator = imports.GetModules().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var module = iterator.GetNext()
+		if sts.Trim(module.GetText(), `"`) == path {
+			alias = module.GetIdentifier()
+			break
+		}
+	}
+	return alias
+}
+
+func (v *generator_) retrieveModulePath(model ModelLike, alias string) string {
+	var imports = model.GetImports()
+	if imports != nil && imports.GetModules() != nil {
+		var iterator = imports.GetModules().GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var module = iterator.GetNext()
+			if module.GetIdentifier() == alias {
+				return sts.Trim(module.GetText(), `"`)
+			}
+		}
+	}
 	var message = fmt.Sprintf(
-		"Missing the following aspect definition: %v",
-		identifier,
+		"The model does not import a module with the following alias: %v",
+		alias,
 	)
 	panic(message)
 }
+
+func (v *generator_) retrieveSpecialization(
+	model ModelLike,
+	identifier string,
+) SpecializationLike {
+	var types = model.GetTypes()
+	if types == nil || types.GetSpecializations() == nil {
+		return nil
+	}
+	var iterator = types.GetSpecializations().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var specialization = iterator.GetNext()
+		var declaration = specialization.GetDeclaration()
+		if declaration.GetIdentifier() == identifier {
+			return specialization
+		}
+	}
+	return nil
+}
+
+func (v *generator_) writeFiles(
+	directory string,
+	files col.CatalogLike[string, string],
+	stamps col.CatalogLike[string, string],
+) {
+	var iterator = files.GetIterator()
+	for iterator.HasNext() {
+		var file = iterator.GetNext()
+		var fileName = file.GetKey()
+		var source = file.GetValue()
+		v.createDirectory(pfp.Dir(directory + fileName))
+		var err = osx.WriteFile(directory+fileName, []byte(source), 0644)
+		if err != nil {
+			panic(err)
+		}
+		if fileName != "Package.go" {
+			// The model is stamped with its own hash once the package is done.
+			stamps.SetValue(fileName, v.hashSource(source))
+		}
+	}
+}
+
+func (v *generator_) writeStamps(
+	directory string,
+	stamps col.CatalogLike[string, string],
+) {
+	var source = "# The hashes of the files generated from the model, do not edit.\n"
+	stamps.SortValues()
+	var iterator = stamps.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		source += association.GetValue() + "  " + association.GetKey() + "\n"
+	}
+	var err = osx.WriteFile(directory+"Package.stamp", []byte(source), 0644)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -15,9 +15,13 @@ package packages_test
 import (
 	fmt "fmt"
 	pac "github.com/craterdog/go-package-framework/v2"
+	ass "github.com/stretchr/testify/assert"
 	osx "os"
+	exe "os/exec"
+	pfp "path/filepath"
 	sts "strings"
 	tes "testing"
+	fst "testing/fstest"
 )
 
 const generatedDirectory = "./generated/"
@@ -41,7 +45,17 @@ func TestInitialization(t *tes.T) {
 }
 
 func TestGeneration(t *tes.T) {
-	var generator = pac.Generator().Make()
+	var generator = pac.Generator().MakeWithOptions(
+		pac.MocksOption,
+		pac.SynchronizedOption,
+		pac.NilChecksOption,
+		pac.ValueMethodsOption,
+		pac.SerializationOption,
+		pac.InstrumentedOption,
+	)
+
+	var markdown = pac.Documenter().MakeWithFormat(pac.MarkdownFormat)
+	var html = pac.Documenter().MakeWithFormat(pac.HTMLFormat)
 
 	var files, err = osx.ReadDir(testDirectory)
 	if err != nil {
@@ -57,18 +71,865 @@ func TestGeneration(t *tes.T) {
 			panic(err)
 		}
 		var directoryName = generatedDirectory + fileName + "/"
-		err = osx.RemoveAll(directoryName)
-		if err != nil {
-			panic(err)
-		}
-		err = osx.MkdirAll(directoryName, 0755)
+		preparePackage(directoryName, string(bytes))
+		generator.GeneratePackage(directoryName)
+		markdown.DocumentPackage(directoryName)
+		html.DocumentPackage(directoryName)
+	}
+
+	// A copy does not share the sequences from the collection framework.
+	var bytes []byte
+	bytes, err = osx.ReadFile(generatedDirectory + "packages/abstractions.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(
+		t,
+		string(bytes),
+		"copy_.sequence_ = col.List[AbstractionLike]().MakeFromSequence(v.sequence_)",
+	)
+
+	// A queue has fields that its MakeWithAttributes constructor cannot set.
+	bytes, err = osx.ReadFile(generatedDirectory + "queues/queue.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.NotContains(t, string(bytes), "MarshalJSON")
+}
+
+func preparePackage(directoryName string, model string) {
+	var err = osx.RemoveAll(directoryName)
+	if err != nil {
+		panic(err)
+	}
+	err = osx.MkdirAll(directoryName, 0755)
+	if err != nil {
+		panic(err)
+	}
+	err = osx.WriteFile(directoryName+"Package.go", []byte(model), 0644)
+	if err != nil {
+		panic(err)
+	}
+}
+
+const classTemplate = `{{.Notice}}package {{.Package}}
+{{imports}}
+// ACCESS
+{{if .Parameters}}
+var {{.Target}}Class = map[string]any{}
+var {{.Target}}Mutex syn.Mutex
+
+func {{.Name}}[{{.Parameters}}]() {{.Name}}ClassLike[{{.Arguments}}] {
+	var result_ {{.Name}}ClassLike[{{.Arguments}}]
+	var name = fmt.Sprintf("%T", result_)
+	{{.Target}}Mutex.Lock()
+	defer {{.Target}}Mutex.Unlock()
+	var class, ok = {{.Target}}Class[name].(*{{.Target}}Class_[{{.Arguments}}])
+	if !ok {
+		class = &{{.Target}}Class_[{{.Arguments}}]{}
+		{{.Target}}Class[name] = class
+	}
+	return class
+}
+{{else}}
+var {{.Target}}Class = &{{.Target}}Class_{}
+
+func {{.Name}}() {{.Name}}ClassLike {
+	return {{.Target}}Class
+}
+{{end}}
+// CLASS
+{{$class := .}}{{$arguments := ""}}{{if .Arguments}}{{$arguments = printf "[%v]" .Arguments}}{{end}}
+type {{.Target}}Class_{{if .Parameters}}[{{.Parameters}}]{{end}} struct {
+{{- range .Constants}}
+	{{.Field}}_ {{.Type}}
+{{- end}}
+}
+{{range .Constants}}
+{{.Comment}}func (c *{{$class.Target}}Class_{{$arguments}}) {{.Name}}() {{.Type}} {
+	logCall("{{$class.Name}}.{{.Name}}")
+	return c.{{.Field}}_
+}
+{{end}}{{range .Constructors}}{{$method := .Name}}
+{{.Comment}}func (c *{{$class.Target}}Class_{{$arguments}}) {{.Name}}({{.Parameters}}) {{.Result}} {
+	logCall("{{$class.Name}}.{{.Name}}")
+{{- range .Checks}}
+	if {{.}} == nil {
+		panic("The {{.}} argument to {{$class.Name}}.{{$method}}() cannot be nil.")
+	}
+{{- end}}
+	return &{{$class.Target}}_{{$arguments}}{
+{{- range .Assignments}}
+		{{.Name}}_: {{.Value}},
+{{- end}}
+	}
+}
+{{end}}{{range .Functions}}
+{{.Comment}}func (c *{{$class.Target}}Class_{{$arguments}}) {{.Name}}({{.Parameters}}) {{.Result}} {
+	logCall("{{$class.Name}}.{{.Name}}")
+	panic("{{$class.Name}}.{{.Name}} is not implemented.")
+}
+{{end}}{{with .Instance}}
+// INSTANCE
+
+type {{$class.Target}}_{{if $class.Parameters}}[{{$class.Parameters}}]{{end}} struct {
+{{- range .Fields}}
+	{{.Name}}_ {{.Type}}
+{{- end}}
+}
+{{range .Attributes}}
+{{.Comment}}func (v *{{$class.Target}}_{{$arguments}}) {{.Name}}({{.Parameter}}) {{.Result}} {
+	logCall("{{$class.Name}}.{{.Name}}")
+{{- if .Result}}
+	return v.{{.Field}}_
+{{- else}}
+	v.{{.Field}}_ = {{.Argument}}
+{{- end}}
+}
+{{end}}{{range .Abstractions}}{{range .Methods}}
+func (v *{{$class.Target}}_{{$arguments}}) {{.Name}}({{.Parameters}}) {{.Result}} {
+	logCall("{{$class.Name}}.{{.Name}}")
+	panic("{{$class.Name}}.{{.Name}} is not implemented.")
+}
+{{end}}{{end}}{{range .Methods}}
+{{.Comment}}func (v *{{$class.Target}}_{{$arguments}}) {{.Name}}({{.Parameters}}) {{.Result}} {
+	logCall("{{$class.Name}}.{{.Name}}")
+	panic("{{$class.Name}}.{{.Name}} is not implemented.")
+}
+{{end}}{{with $value := .Value}}{{range .Methods}}{{if eq . "Equal"}}
+func (v *{{$class.Target}}_{{$arguments}}) Equal(other any) bool {
+	logCall("{{$class.Name}}.Equal")
+	var that, ok = other.(*{{$class.Target}}_{{$arguments}})
+	if !ok {
+		return false
+	}
+{{- range $value.Fields}}
+{{- if eq .Kind "functional"}}
+	if (v.{{.Name}}_ == nil) != (that.{{.Name}}_ == nil) {
+		return false
+	}
+{{- else if and (eq .Kind "value") .IsComparable}}
+	if v.{{.Name}}_ != that.{{.Name}}_ {
+		return false
+	}
+{{- else}}
+	if !ref.DeepEqual(v.{{.Name}}_, that.{{.Name}}_) {
+		return false
+	}
+{{- end}}
+{{- end}}
+	return true
+}
+{{end}}{{end}}{{end}}{{with $serialization := .Serialization}}{{range .Methods}}{{if eq . "MarshalJSON"}}
+func (v *{{$class.Target}}_{{$arguments}}) MarshalJSON() ([]byte, error) {
+	logCall("{{$class.Name}}.MarshalJSON")
+	return jsn.Marshal(map[string]any{
+{{- range $serialization.Parameters}}
+		"{{.Field}}": v.{{.Field}}_,
+{{- end}}
+	})
+}
+{{end}}{{end}}{{end}}{{end}}`
+
+const loggingFile = `package queues
+
+import (
+	fmt "fmt"
+)
+
+func logCall(name string) {
+	fmt.Println(name)
+}
+`
+
+func TestImportedAspects(t *tes.T) {
+	var generator = pac.Generator().MakeWithOptions(
+		pac.SynchronizedOption,
+		pac.InstrumentedOption,
+	)
+	var bytes, err = osx.ReadFile(testDirectory + "bags.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var directoryName = generatedDirectory + "imported/"
+	preparePackage(directoryName, string(bytes))
+	generator.GeneratePackage(directoryName)
+
+	// The class stubs out the methods of the imported aspect.
+	bytes, err = osx.ReadFile(directoryName + "bag.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(t, string(bytes), "func (v *bag_) GetIterator() col.IteratorLike[string] {")
+
+	// The synchronized wrapper forwards the methods of the imported aspect
+	// while holding its mutex rather than embedding the delegate.
+	bytes, err = osx.ReadFile(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	var synchronized = string(bytes)
+	ass.Contains(t, synchronized, `func (v *synchronizedBag_) AsArray() []string {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	return v.delegate_.AsArray()
+}`)
+	ass.NotContains(t, synchronized, "\tcol.Sequential[string]\n")
+
+	// The instrumented wrapper observes the methods of the imported aspect too.
+	bytes, err = osx.ReadFile(directoryName + "instrumented.go")
+	if err != nil {
+		panic(err)
+	}
+	var instrumented = string(bytes)
+	ass.Contains(t, instrumented, "func (v *instrumentedBag_) AsArray() (result_ []string) {")
+	ass.Contains(t, instrumented, `"Bag",
+			"AsArray",`)
+	ass.NotContains(t, instrumented, "\tcol.Sequential[string]\n")
+}
+
+func TestSynchronized(t *tes.T) {
+	var generator = pac.Generator().MakeWithOptions(pac.SynchronizedOption)
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var model = sts.ReplaceAll(
+		string(bytes),
+		"\t// Methods\n\tGetNext() T\n",
+		"\t// Attributes\n\tGetSlot() int\n\n\t// Methods\n\tGetNext() T\n",
+	)
+	var directoryName = generatedDirectory + "synchronized/"
+	preparePackage(directoryName, model)
+	generator.GeneratePackage(directoryName)
+
+	// The getters inherited from an embedded instance interface are guarded
+	// using a read lock, its other methods using a write lock.
+	bytes, err = osx.ReadFile(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	var synchronized = string(bytes)
+	ass.Contains(t, synchronized, `func (v *synchronizedQueue_[T]) GetSlot() int {
+	v.mutex_.RLock()
+	defer v.mutex_.RUnlock()
+	return v.delegate_.GetSlot()
+}`)
+	ass.Contains(t, synchronized, `func (v *synchronizedQueue_[T]) GetNext() T {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	return v.delegate_.GetNext()
+}`)
+}
+
+func TestNilChecks(t *tes.T) {
+	var generator = pac.Generator().MakeWithOptions(pac.NilChecksOption)
+	var bytes, err = osx.ReadFile(testDirectory + "bags.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var source = sts.Replace(string(bytes), "// INTERFACES", `// TYPES
+
+// Specializations
+
+/*
+Name is a specialized type representing any name in a bag.
+*/
+type Name any
+
+// INTERFACES`, 1)
+	source = sts.Replace(source, "\tMakeWithCapacity(capacity uint) BagLike\n", `	MakeWithCapacity(capacity uint) BagLike
+	MakeWithSequence(sequence col.Sequential[string]) BagLike
+	/*
+		MakeWithNames creates a bag whose default name may be nil.
+		optional: default_
+	*/
+	MakeWithNames(name Name, default_ Name) BagLike
+`, 1)
+	var directoryName = generatedDirectory + "nilchecks/"
+	preparePackage(directoryName, source)
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "bag.go")
+	if err != nil {
+		panic(err)
+	}
+	var class = string(bytes)
+
+	// Imported interfaces and specializations of any are checked for nil.
+	ass.Contains(t, class, "\tif sequence == nil {\n")
+	ass.Contains(t, class, "\tif name == nil {\n")
+
+	// Optional parameters and non-interface types are not.
+	ass.NotContains(t, class, "\tif default_ == nil {\n")
+	ass.NotContains(t, class, "\tif capacity == nil {\n")
+
+	// An optional parameter must name a parameter of its constructor.
+	source = sts.Replace(source, "optional: default_", "optional: fallback", 1)
+	err = osx.WriteFile(directoryName+"Package.go", []byte(source), 0644)
+	if err != nil {
+		panic(err)
+	}
+	ass.PanicsWithValue(
+		t,
+		"The following class files could not be generated:\n"+
+			"The optional parameter fallback is not a parameter of the constructor MakeWithNames.\n",
+		func() { generator.GeneratePackage(directoryName) },
+	)
+}
+
+func TestTemplates(t *tes.T) {
+	var templates = fst.MapFS{
+		"class.tmpl": &fst.MapFile{Data: []byte(classTemplate)},
+	}
+	var generator = pac.Generator().MakeWithTemplates(
+		templates,
+		pac.NilChecksOption,
+		pac.ValueMethodsOption,
+		pac.SerializationOption,
+	)
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var directoryName = generatedDirectory + "templates/"
+	preparePackage(directoryName, string(bytes))
+	err = osx.WriteFile(directoryName+"logging.go", []byte(loggingFile), 0644)
+	if err != nil {
+		panic(err)
+	}
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(t, string(bytes), `logCall("Queue.RemoveHead")`)
+
+	// The template is given the same default assignments as the built-in
+	// templates.
+	var words = sts.Join(sts.Fields(string(bytes)), " ")
+	ass.Contains(t, words, "capacity_: 16, protected_: true, closed_: false, }")
+
+	// The template is given the value methods that the options enable as data,
+	// so it only compares the presence of any functional attributes.
+	ass.Contains(t, string(bytes), "func (v *queue_[T]) Equal(other any) bool {")
+	ass.Contains(t, string(bytes), "if (v.comparer_ == nil) != (that.comparer_ == nil) {")
+	ass.Contains(t, string(bytes), "if v.capacity_ != that.capacity_ {")
+	ass.NotContains(t, string(bytes), "ref.DeepEqual(v.comparer_, that.comparer_)")
+}
+
+func generateFromTemplate(
+	directoryName string,
+	template string,
+	options ...pac.OptionType,
+) (message string) {
+	defer func() {
+		message = fmt.Sprint(recover())
+	}()
+	var templates = fst.MapFS{
+		"class.tmpl": &fst.MapFile{Data: []byte(template)},
+	}
+	var generator = pac.Generator().MakeWithTemplates(templates, options...)
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	preparePackage(directoryName, string(bytes))
+	generator.GeneratePackage(directoryName)
+	return
+}
+
+func TestCompilation(t *tes.T) {
+	// The generated source is not syntactically valid.
+	var directoryName = generatedDirectory + "unformatted/"
+	var message = generateFromTemplate(directoryName, "package queues\n\nfunc {\n")
+	ass.Contains(t, message, directoryName+"items.go:3:6:")
+	_, err := osx.Stat(directoryName + "items.go")
+	ass.True(t, osx.IsNotExist(err))
+	osx.RemoveAll(directoryName)
+
+	// The generated source does not type check since logCall is undefined.
+	directoryName = generatedDirectory + "untyped/"
+	message = generateFromTemplate(directoryName, classTemplate)
+	ass.Contains(t, message, "untyped/queue.go:49:2: undefined: logCall")
+	_, err = osx.Stat(directoryName + "queue.go")
+	ass.True(t, osx.IsNotExist(err))
+	_, err = osx.Stat(directoryName + "Package.stamp")
+	ass.True(t, osx.IsNotExist(err))
+	osx.RemoveAll(directoryName)
+
+	// The type check may be skipped, e.g. while a hand-written file is missing.
+	directoryName = generatedDirectory + "unchecked/"
+	message = generateFromTemplate(directoryName, classTemplate, pac.UncheckedOption)
+	ass.Equal(t, "<nil>", message)
+	_, err = osx.Stat(directoryName + "queue.go")
+	ass.Nil(t, err)
+	osx.RemoveAll(directoryName)
+
+	// The imports are resolved using the module that contains the package
+	// rather than the working directory.
+	var bytes []byte
+	bytes, err = osx.ReadFile(testDirectory + "bags.gomn")
+	if err != nil {
+		panic(err)
+	}
+	directoryName, err = pfp.Abs(generatedDirectory + "elsewhere")
+	if err != nil {
+		panic(err)
+	}
+	directoryName += "/"
+	preparePackage(directoryName, string(bytes))
+	var working string
+	working, err = osx.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	err = osx.Chdir(osx.TempDir())
+	if err != nil {
+		panic(err)
+	}
+	defer osx.Chdir(working)
+	pac.Generator().Make().GeneratePackage(directoryName)
+	_, err = osx.Stat(directoryName + "bag.go")
+	ass.Nil(t, err)
+}
+
+const importsTemplate = `{{.Notice}}
+package {{.Package}}
+{{imports}}
+// The queue is built from col.List and reports errors using fmt.Sprintf.
+type {{.Target}}_ struct {
+	syn.Mutex
+}
+`
+
+func TestImports(t *tes.T) {
+	var directoryName = generatedDirectory + "imports/"
+	var message = generateFromTemplate(directoryName, importsTemplate)
+	ass.Equal(t, "<nil>", message)
+	var bytes, err = osx.ReadFile(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	var class = string(bytes)
+	ass.Contains(t, class, `syn "sync"`)
+	ass.NotContains(t, class, `fmt "fmt"`)
+}
+
+func BenchmarkGeneration(b *tes.B) {
+	// The type check of the generated package would dominate the benchmark so
+	// it is skipped to time the generation itself.
+	var generator = pac.Generator().MakeWithOptions(pac.UncheckedOption)
+	for _, fileName := range []string{"collections", "packages"} {
+		var bytes, err = osx.ReadFile(testDirectory + fileName + ".gomn")
 		if err != nil {
 			panic(err)
 		}
-		err = osx.WriteFile(directoryName+"Package.go", bytes, 0644)
-		if err != nil {
-			panic(err)
+		var directoryName = generatedDirectory + "benchmarks/" + fileName + "/"
+		b.Run(fileName, func(b *tes.B) {
+			for range b.N {
+				b.StopTimer()
+				preparePackage(directoryName, string(bytes))
+				b.StartTimer()
+				generator.GeneratePackage(directoryName)
+			}
+		})
+	}
+}
+
+func TestIncremental(t *tes.T) {
+	var generator = pac.Generator().MakeWithOptions(pac.SynchronizedOption)
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var model = string(bytes)
+	var directoryName = generatedDirectory + "incremental/"
+	preparePackage(directoryName, string(bytes))
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "Package.stamp")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(t, string(bytes), "  queue.go\n")
+	ass.Contains(t, string(bytes), "  synchronized.go\n")
+
+	// Regenerating an unchanged model leaves the package alone.
+	var edited = "// Edited by hand.\n"
+	bytes, err = osx.ReadFile(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	var synchronized = string(bytes) + edited
+	err = osx.WriteFile(directoryName+"synchronized.go", []byte(synchronized), 0644)
+	if err != nil {
+		panic(err)
+	}
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Equal(t, synchronized, string(bytes))
+
+	// Only the pristine class files whose interfaces changed are regenerated.
+	bytes, err = osx.ReadFile(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	var queue = string(bytes) + edited
+	err = osx.WriteFile(directoryName+"queue.go", []byte(queue), 0644)
+	if err != nil {
+		panic(err)
+	}
+	model = sts.ReplaceAll(
+		model,
+		"\tCompare(first Item, second Item) bool\n",
+		"\tCompare(first Item, second Item) bool\n\tCount(items []Item) int\n",
+	)
+	err = osx.WriteFile(directoryName+"Package.go", []byte(model), 0644)
+	if err != nil {
+		panic(err)
+	}
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "items.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(t, string(bytes), "Count(items []Item) int")
+	bytes, err = osx.ReadFile(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Equal(t, queue, string(bytes))
+	bytes, err = osx.ReadFile(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Equal(t, synchronized, string(bytes))
+
+	// A generated file that was edited by hand is never overwritten.
+	model = sts.ReplaceAll(model, "\tCloseQueue()\n", "\tCloseQueue()\n\tOpenQueue()\n")
+	err = osx.WriteFile(directoryName+"Package.go", []byte(model), 0644)
+	if err != nil {
+		panic(err)
+	}
+	ass.PanicsWithValue(
+		t,
+		"The generated file \""+directoryName+"synchronized.go\" was edited since "+
+			"it was generated, remove it to regenerate it.",
+		func() { generator.GeneratePackage(directoryName) },
+	)
+	bytes, err = osx.ReadFile(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Equal(t, synchronized, string(bytes))
+
+	// Removing the edited files lets them be regenerated.
+	err = osx.Remove(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	err = osx.Remove(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "synchronized.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(t, string(bytes), "func (v *synchronizedQueue_[T]) OpenQueue() {")
+}
+
+func TestRecovery(t *tes.T) {
+	var templates = fst.MapFS{
+		"class.tmpl": &fst.MapFile{Data: []byte(classTemplate)},
+	}
+	var generator = pac.Generator().MakeWithTemplates(templates)
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var directoryName = generatedDirectory + "recovery/"
+	preparePackage(directoryName, string(bytes))
+
+	// A failed run does not type check so it leaves the package untouched.
+	ass.Panics(t, func() { generator.GeneratePackage(directoryName) })
+	_, err = osx.Stat(directoryName + "queue.go")
+	ass.True(t, osx.IsNotExist(err))
+
+	// Once the model is fixed the files are generated as usual.
+	err = osx.WriteFile(directoryName+"logging.go", []byte(loggingFile), 0644)
+	if err != nil {
+		panic(err)
+	}
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	var queue = string(bytes)
+	ass.Contains(t, queue, `logCall("Queue.RemoveHead")`)
+
+	// A run that was interrupted before its stamps were written still
+	// recognizes the files that it generated.
+	err = osx.Remove(directoryName + "Package.stamp")
+	if err != nil {
+		panic(err)
+	}
+	generator.GeneratePackage(directoryName)
+	bytes, err = osx.ReadFile(directoryName + "Package.stamp")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(t, string(bytes), "  queue.go\n")
+	bytes, err = osx.ReadFile(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Equal(t, queue, string(bytes))
+}
+
+const serializationTestFile = `package cdcn_test
+
+import (
+	jsn "encoding/json"
+	cdc "github.com/craterdog/go-package-framework/v2/generated/serialized"
+	sts "strings"
+	tes "testing"
+)
+
+func TestSerialization(t *tes.T) {
+	var token = cdc.Token().MakeWithAttributes(3, 7, cdc.StringToken, "alpha")
+	var bytes, err = jsn.Marshal(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sts.Contains(string(bytes), "\"type\":\"StringToken\"") {
+		t.Errorf("The token type was not serialized by name: %v", string(bytes))
+	}
+	var copy_ = cdc.Token().MakeWithAttributes(0, 0, cdc.ErrorToken, "")
+	err = jsn.Unmarshal(bytes, copy_)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !copy_.(interface{ Equal(other any) bool }).Equal(token) {
+		t.Errorf("The token did not survive a round trip: %v", copy_)
+	}
+}
+
+type notation interface {
+	MarshalCDCN() ([]byte, error)
+	UnmarshalCDCN(data []byte) error
+}
+
+func TestNotation(t *tes.T) {
+	var token = cdc.Token().MakeWithAttributes(3, 7, cdc.StringToken, "alpha")
+	var bytes, err = token.(notation).MarshalCDCN()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sts.Contains(string(bytes), "\"type\": \"StringToken\"") {
+		t.Errorf("The token type was not encoded by name: %v", string(bytes))
+	}
+	var copy_ = cdc.Token().MakeWithAttributes(0, 0, cdc.ErrorToken, "")
+	err = copy_.(notation).UnmarshalCDCN(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !copy_.(interface{ Equal(other any) bool }).Equal(token) {
+		t.Errorf("The token did not survive a round trip: %v", copy_)
+	}
+	err = copy_.(notation).UnmarshalCDCN([]byte("[\"type\": \"BogusToken\"](Catalog)"))
+	if err == nil {
+		t.Errorf("An invalid token type was decoded.")
+	}
+	err = copy_.(notation).UnmarshalCDCN([]byte("[1, 2"))
+	if err == nil {
+		t.Errorf("Invalid CDCN was decoded.")
+	}
+}
+`
+
+func TestSerialization(t *tes.T) {
+	var generator = pac.Generator().MakeWithOptions(
+		pac.ValueMethodsOption,
+		pac.SerializationOption,
+	)
+	var bytes, err = osx.ReadFile(testDirectory + "cdcn.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var directoryName = generatedDirectory + "serialized/"
+	preparePackage(directoryName, string(bytes))
+	generator.GeneratePackage(directoryName)
+	err = osx.WriteFile(
+		directoryName+"serialization_test.go",
+		[]byte(serializationTestFile),
+		0644,
+	)
+	if err != nil {
+		panic(err)
+	}
+	var command = exe.Command("go", "test", "-run", "TestSerialization|TestNotation", ".")
+	command.Dir = directoryName
+	var output []byte
+	output, err = command.CombinedOutput()
+	t.Log(string(output))
+	ass.Nil(t, err)
+}
+
+const mockTestFile = `package mocks_test
+
+import (
+	bgs "github.com/craterdog/go-package-framework/v2/generated/mocked"
+	moc "github.com/craterdog/go-package-framework/v2/generated/mocked/mocks"
+	tes "testing"
+)
+
+func TestMock(t *tes.T) {
+	var mock = &moc.MockBag{}
+	var bag bgs.BagLike = mock
+	var control = moc.Control(mock)
+
+	// The stubbed results are returned, including those of the imported aspect
+	// and of a method whose name is also a method of the mock control.
+	control.Stub("GetCapacity", uint(8))
+	control.Stub("AsArray", []string{"alpha"})
+	control.Stub("Verify", true)
+	if bag.GetCapacity() != 8 {
+		t.Error("The stubbed capacity was not returned.")
+	}
+	if len(bag.AsArray()) != 1 {
+		t.Error("The stubbed array was not returned.")
+	}
+	if !bag.Verify() {
+		t.Error("The stubbed verification was not returned.")
+	}
+	if bag.GetIterator() != nil {
+		t.Error("A method without a stubbed result must return its zero value.")
+	}
+
+	// Each call is recorded and verified.
+	control.Expect("AddName", "beta")
+	bag.AddName("beta")
+	if len(control.GetCalls("AsArray")) != 1 {
+		t.Error("The call to the imported aspect was not recorded.")
+	}
+	control.Verify(t)
+
+	// A stubbed result with the wrong type is reported.
+	defer func() {
+		var expected = "The result stubbed for MockBag.GetCapacity must have the type uint."
+		if recover() != expected {
+			t.Error("A wrong-typed stubbed result must panic.")
 		}
-		generator.GeneratePackage(directoryName)
+	}()
+	control.Stub("GetCapacity", 8)
+	bag.GetCapacity()
+}
+`
+
+func TestMocks(t *tes.T) {
+	var generator = pac.Generator().MakeWithOptions(pac.MocksOption)
+	var bytes, err = osx.ReadFile(testDirectory + "bags.gomn")
+	if err != nil {
+		panic(err)
+	}
+	// A mocked interface may declare the same methods as the mock control.
+	var source = sts.Replace(
+		string(bytes),
+		"\tAddName(name string)\n",
+		"\tAddName(name string)\n\tVerify() bool\n",
+		1,
+	)
+	var directoryName = generatedDirectory + "mocked/"
+	preparePackage(directoryName, source)
+	generator.GeneratePackage(directoryName)
+	err = osx.WriteFile(
+		directoryName+"mocks/mock_test.go",
+		[]byte(mockTestFile),
+		0644,
+	)
+	if err != nil {
+		panic(err)
+	}
+	var command = exe.Command("go", "test", "-run", "TestMock", "./mocks")
+	command.Dir = directoryName
+	var output []byte
+	output, err = command.CombinedOutput()
+	t.Log(string(output))
+	ass.Nil(t, err)
+}
+
+// The class access test runs inside each generated package so that it exercises
+// the generated Queue[T]() function itself.
+const accessTestFile = `package queues
+
+import (
+	fmt "fmt"
+	tes "testing"
+)
+
+func TestClassAccess(t *tes.T) {
+	if Queue[string]() != Queue[string]() {
+		t.Error("Each access to a generic class must return the same class.")
+	}
+	if fmt.Sprintf("%p", Queue[string]()) == fmt.Sprintf("%p", Queue[int]()) {
+		t.Error("Each instantiation of a generic class must have its own class.")
+	}
+}
+`
+
+func TestClassAccess(t *tes.T) {
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+
+	// The built-in templates generate the lock-free class access.
+	var generator = pac.Generator().Make()
+	var directoryName = generatedDirectory + "access/"
+	preparePackage(directoryName, string(bytes))
+	generator.GeneratePackage(directoryName)
+	testClassAccess(t, directoryName, "var queueClass syn.Map")
+
+	// The class template generates the mutex guarded class access that the
+	// built-in templates replaced, and both must return the same class for the
+	// same type arguments.
+	var templates = fst.MapFS{
+		"class.tmpl": &fst.MapFile{Data: []byte(classTemplate)},
+	}
+	generator = pac.Generator().MakeWithTemplates(templates)
+	directoryName = generatedDirectory + "mutexaccess/"
+	preparePackage(directoryName, string(bytes))
+	err = osx.WriteFile(directoryName+"logging.go", []byte(loggingFile), 0644)
+	if err != nil {
+		panic(err)
+	}
+	generator.GeneratePackage(directoryName)
+	testClassAccess(t, directoryName, "var queueMutex syn.Mutex")
+}
+
+func testClassAccess(t *tes.T, directoryName string, reference string) {
+	var bytes, err = osx.ReadFile(directoryName + "queue.go")
+	if err != nil {
+		panic(err)
+	}
+	ass.Contains(t, string(bytes), reference)
+	err = osx.WriteFile(
+		directoryName+"access_test.go",
+		[]byte(accessTestFile),
+		0644,
+	)
+	if err != nil {
+		panic(err)
 	}
+	var command = exe.Command("go", "test", "-run", "TestClassAccess", ".")
+	command.Dir = directoryName
+	var output []byte
+	output, err = command.CombinedOutput()
+	t.Log(string(output))
+	ass.Nil(t, err)
 }
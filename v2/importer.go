@@ -0,0 +1,115 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3"
+	imp "go/importer"
+	tok "go/token"
+	typ "go/types"
+	iox "io"
+	osx "os"
+	exe "os/exec"
+	sts "strings"
+	syn "sync"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var importerClass = &importerClass_{
+	// This class does not initialize any class constants.
+}
+
+// Function
+
+func importer() *importerClass_ {
+	return importerClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type importerClass_ struct {
+	// This class does not define any class constants.
+}
+
+// Constructors
+
+func (c *importerClass_) MakeWithDirectory(directory string) *importer_ {
+	var result_ = &importer_{
+		directory_: directory,
+		packages_:  col.Catalog[string, *typ.Package]().Make(),
+	}
+	result_.importer_ = imp.ForCompiler(tok.NewFileSet(), "gc", result_.lookupExport)
+	return result_
+}
+
+// INSTANCE METHODS
+
+// Target
+
+/*
+importer_ resolves the imports of a generated package relative to the module
+that contains its directory rather than the working directory of the process.
+The packages that are pending in memory take precedence over those on disk.
+*/
+type importer_ struct {
+	mutex_     syn.Mutex // Guards the importer, it is not safe for concurrent use.
+	directory_ string
+	packages_  col.CatalogLike[string, *typ.Package]
+	importer_  typ.Importer
+}
+
+// Public
+
+func (v *importer_) AddPackage(path string, package_ *typ.Package) {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	v.packages_.SetValue(path, package_)
+}
+
+func (v *importer_) Import(path string) (*typ.Package, error) {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	var package_ = v.packages_.GetValue(path)
+	if package_ != nil {
+		return package_, nil
+	}
+	return v.importer_.Import(path)
+}
+
+// Private
+
+func (v *importer_) lookupExport(path string) (iox.ReadCloser, error) {
+	// The go command locates (and if necessary compiles) the package using the
+	// module that contains the directory.
+	var command = exe.Command("go", "list", "-export", "-f", "{{.Export}}", "--", path)
+	command.Dir = v.directory_
+	var output, err = command.Output()
+	if err != nil {
+		var exit, ok = err.(*exe.ExitError)
+		if ok {
+			err = fmt.Errorf("%v: %v", err, sts.TrimSpace(string(exit.Stderr)))
+		}
+		return nil, err
+	}
+	var export = sts.TrimSpace(string(output))
+	if len(export) == 0 {
+		return nil, fmt.Errorf("The package %v has no export data.", path)
+	}
+	return osx.Open(export)
+}
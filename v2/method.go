@@ -41,11 +41,13 @@ type methodClass_ struct {
 // Constructors
 
 func (c *methodClass_) MakeWithAttributes(
+	comment string,
 	identifier string,
 	parameters ParametersLike,
 	result ResultLike,
 ) MethodLike {
 	return &method_{
+		comment_:    comment,
 		identifier_: identifier,
 		parameters_: parameters,
 		result_:     result,
@@ -59,6 +61,7 @@ func (c *methodClass_) MakeWithAttributes(
 // Target
 
 type method_ struct {
+	comment_    string
 	identifier_ string
 	parameters_ ParametersLike
 	result_     ResultLike
@@ -66,6 +69,10 @@ type method_ struct {
 
 // Attributes
 
+func (v *method_) GetComment() string {
+	return v.comment_
+}
+
 func (v *method_) GetIdentifier() string {
 	return v.identifier_
 }
@@ -40,9 +40,14 @@ type parameterClass_ struct {
 
 // Constructors
 
-func (c *parameterClass_) MakeWithAttributes(identifier string, abstraction AbstractionLike) ParameterLike {
+func (c *parameterClass_) MakeWithAttributes(
+	identifier string,
+	variadic bool,
+	abstraction AbstractionLike,
+) ParameterLike {
 	return &parameter_{
 		identifier_:  identifier,
+		variadic_:    variadic,
 		abstraction_: abstraction,
 	}
 }
@@ -55,6 +60,7 @@ func (c *parameterClass_) MakeWithAttributes(identifier string, abstraction Abst
 
 type parameter_ struct {
 	identifier_  string
+	variadic_    bool
 	abstraction_ AbstractionLike
 }
 
@@ -64,6 +70,10 @@ func (v *parameter_) GetIdentifier() string {
 	return v.identifier_
 }
 
+func (v *parameter_) IsVariadic() bool {
+	return v.variadic_
+}
+
 func (v *parameter_) GetAbstraction() AbstractionLike {
 	return v.abstraction_
 }
@@ -150,6 +150,30 @@ func (v *parser_) generateGrammar(expected string, names ...string) string {
 	return message
 }
 
+/*
+This private instance method removes the indentation of a comment that appears
+within an interface definition so that it can be re-indented when formatted.
+The indentation is taken from the line containing the closing delimiter, and
+like gofmt, the body of the comment is expected to be indented one more level.
+*/
+func (v *parser_) dedentComment(comment string) string {
+	var lines = sts.Split(comment, "\n")
+	var indentation string
+	for _, line := range lines {
+		if sts.TrimSpace(line) == "*/" {
+			indentation = sts.TrimSuffix(line, "*/")
+		}
+	}
+	for index, line := range lines[1:] {
+		line = sts.TrimPrefix(line, indentation)
+		if line != "*/" {
+			line = sts.TrimPrefix(line, "\t")
+		}
+		lines[index+1] = line
+	}
+	return sts.Join(lines, "\n")
+}
+
 /*
 This private instance method attempts to read the next token from the token
 stream and return it.
@@ -422,10 +446,24 @@ func (v *parser_) parseAttribute() (
 	token TokenLike,
 	ok bool,
 ) {
+	// Attempt to parse an optional comment.
+	var comment string
+	comment, _, _ = v.parseToken(CommentToken, "")
+	comment = v.dedentComment(comment)
+
 	// Attempt to parse an identifier.
 	var identifier string
 	identifier, token, ok = v.parseToken(IdentifierToken, "")
 	if !ok {
+		if len(comment) > 0 {
+			var message = v.formatError(token)
+			message += v.generateGrammar("Identifier",
+				"attribute",
+				"parameter",
+				"abstraction",
+			)
+			panic(message)
+		}
 		// This is not a attribute.
 		return attribute, token, false
 	}
@@ -460,8 +498,18 @@ func (v *parser_) parseAttribute() (
 	// Attempt to parse an optional abstraction.
 	var abstraction, _, _ = v.parseAbstraction()
 
+	// Attempt to parse an optional annotation containing a default value.
+	var annotation, _, _ = v.parseToken(AnnotationToken, "")
+	var default_ = sts.TrimPrefix(annotation, "// = ")
+
 	// Found a attribute.
-	attribute = Attribute().MakeWithAttributes(identifier, parameter, abstraction)
+	attribute = Attribute().MakeWithAttributes(
+		comment,
+		identifier,
+		parameter,
+		abstraction,
+		default_,
+	)
 	return attribute, token, true
 }
 
@@ -626,10 +674,23 @@ func (v *parser_) parseConstant() (
 	token TokenLike,
 	ok bool,
 ) {
+	// Attempt to parse an optional comment.
+	var comment string
+	comment, _, _ = v.parseToken(CommentToken, "")
+	comment = v.dedentComment(comment)
+
 	// Attempt to parse an identifier.
 	var identifier string
 	identifier, token, ok = v.parseToken(IdentifierToken, "")
 	if !ok {
+		if len(comment) > 0 {
+			var message = v.formatError(token)
+			message += v.generateGrammar("Identifier",
+				"constant",
+				"abstraction",
+			)
+			panic(message)
+		}
 		// This is not a constant.
 		return constant, token, false
 	}
@@ -668,8 +729,12 @@ func (v *parser_) parseConstant() (
 		panic(message)
 	}
 
+	// Attempt to parse an optional annotation containing the constant value.
+	var annotation, _, _ = v.parseToken(AnnotationToken, "")
+	var value = sts.TrimPrefix(annotation, "// = ")
+
 	// Found a constant.
-	constant = Constant().MakeWithAttributes(identifier, abstraction)
+	constant = Constant().MakeWithAttributes(comment, identifier, abstraction, value)
 	return constant, token, true
 }
 
@@ -712,10 +777,24 @@ func (v *parser_) parseConstructor() (
 	token TokenLike,
 	ok bool,
 ) {
+	// Attempt to parse an optional comment.
+	var comment string
+	comment, _, _ = v.parseToken(CommentToken, "")
+	comment = v.dedentComment(comment)
+
 	// Attempt to parse an identifier.
 	var identifier string
 	identifier, token, ok = v.parseToken(IdentifierToken, "")
 	if !ok {
+		if len(comment) > 0 {
+			var message = v.formatError(token)
+			message += v.generateGrammar("Identifier",
+				"constructor",
+				"parameters",
+				"abstraction",
+			)
+			panic(message)
+		}
 		// This is not a constructor.
 		return constructor, token, false
 	}
@@ -761,7 +840,7 @@ func (v *parser_) parseConstructor() (
 	}
 
 	// Found a constructor.
-	constructor = Constructor().MakeWithAttributes(identifier, parameters, abstraction)
+	constructor = Constructor().MakeWithAttributes(comment, identifier, parameters, abstraction)
 	return constructor, token, true
 }
 
@@ -937,10 +1016,24 @@ func (v *parser_) parseFunction() (
 	token TokenLike,
 	ok bool,
 ) {
+	// Attempt to parse an optional comment.
+	var comment string
+	comment, _, _ = v.parseToken(CommentToken, "")
+	comment = v.dedentComment(comment)
+
 	// Attempt to parse an identifier.
 	var identifier string
 	identifier, token, ok = v.parseToken(IdentifierToken, "")
 	if !ok {
+		if len(comment) > 0 {
+			var message = v.formatError(token)
+			message += v.generateGrammar("Identifier",
+				"function",
+				"parameters",
+				"result",
+			)
+			panic(message)
+		}
 		// This is not a function.
 		return function, token, false
 	}
@@ -986,7 +1079,7 @@ func (v *parser_) parseFunction() (
 	}
 
 	// Found a function.
-	function = Function().MakeWithAttributes(identifier, parameters, result)
+	function = Function().MakeWithAttributes(comment, identifier, parameters, result)
 	return function, token, true
 }
 
@@ -1400,10 +1493,24 @@ func (v *parser_) parseMethod() (
 	token TokenLike,
 	ok bool,
 ) {
+	// Attempt to parse an optional comment.
+	var comment string
+	comment, _, _ = v.parseToken(CommentToken, "")
+	comment = v.dedentComment(comment)
+
 	// Attempt to parse an identifier.
 	var identifier string
 	identifier, token, ok = v.parseToken(IdentifierToken, "")
 	if !ok {
+		if len(comment) > 0 {
+			var message = v.formatError(token)
+			message += v.generateGrammar("Identifier",
+				"method",
+				"parameters",
+				"result",
+			)
+			panic(message)
+		}
 		// This is not a method.
 		return method, token, false
 	}
@@ -1439,7 +1546,7 @@ func (v *parser_) parseMethod() (
 	var result, _, _ = v.parseResult()
 
 	// Found a method.
-	method = Method().MakeWithAttributes(identifier, parameters, result)
+	method = Method().MakeWithAttributes(comment, identifier, parameters, result)
 	return method, token, true
 }
 
@@ -1637,6 +1744,10 @@ func (v *parser_) parseParameter() (
 		return parameter, token, false
 	}
 
+	// Attempt to parse an optional variadic delimiter.
+	var variadic bool
+	_, _, variadic = v.parseToken(DelimiterToken, "...")
+
 	// Attempt to parse an abstraction.
 	var abstraction AbstractionLike
 	abstraction, token, ok = v.parseAbstraction()
@@ -1650,7 +1761,7 @@ func (v *parser_) parseParameter() (
 	}
 
 	// Found a parameter.
-	parameter = Parameter().MakeWithAttributes(identifier, abstraction)
+	parameter = Parameter().MakeWithAttributes(identifier, variadic, abstraction)
 	return parameter, token, true
 }
 
@@ -2000,17 +2111,17 @@ var grammar = map[string]string{
 	"arguments":       `abstraction ("," abstraction)* ","?`,
 	"aspect":          `declaration "interface" "{" methods? "}"`,
 	"aspects":         `"// Aspects" aspect+`,
-	"attribute":       `Identifier "(" parameter? ")" abstraction?`,
+	"attribute":       `Comment? Identifier "(" parameter? ")" abstraction? Annotation?`,
 	"attributes":      `"// Attributes" attribute+`,
 	"class":           `declaration "interface" "{" constants? constructors? functions? "}"`,
 	"classes":         `"// Classes" class+`,
-	"constant":        `Identifier "(" ")" abstraction`,
+	"constant":        `Comment? Identifier "(" ")" abstraction Annotation?`,
 	"constants":       `"// Constants" constant+`,
-	"constructor":     `Identifier "(" parameters? ")" abstraction`,
+	"constructor":     `Comment? Identifier "(" parameters? ")" abstraction`,
 	"constructors":    `"// Constructors" constructor+`,
 	"declaration":     `Comment "type" Identifier ("[" parameters "]")?`,
 	"enumeration":     `"const" "(" values ")"`,
-	"function":        `Identifier "(" parameters? ")" result`,
+	"function":        `Comment? Identifier "(" parameters? ")" result`,
 	"functional":      `declaration "func" "(" parameters? ")" result`,
 	"functionals":     `"// Functionals" functional+`,
 	"functions":       `"// Functions" function+`,
@@ -2019,13 +2130,13 @@ var grammar = map[string]string{
 	"instance":        `declaration "interface" "{" attributes? abstractions? methods? "}"`,
 	"instances":       `"// Instances" instance+`,
 	"interfaces":      `"// INTERFACES" aspects? classes? instances?`,
-	"method":          `Identifier "(" parameters? ")" result?`,
+	"method":          `Comment? Identifier "(" parameters? ")" result?`,
 	"methods":         `"// Methods" method+`,
 	"module":          `Identifier Text`,
 	"modules":         `module+`,
 	"notice":          `Comment`,
 	"package":         `notice header imports? types? interfaces?`,
-	"parameter":       `Identifier abstraction`,
+	"parameter":       `Identifier "..."? abstraction`,
 	"parameters":      `parameter ("," parameter)* ","?`,
 	"prefix":          `"[" "]" | "map" "[" Identifier "]" | "chan" | Identifier "."`,
 	"result":          `abstraction | "(" parameters ")"`,
@@ -18,6 +18,7 @@ import (
 	ass "github.com/stretchr/testify/assert"
 	osx "os"
 	sts "strings"
+	syn "sync"
 	tes "testing"
 )
 
@@ -33,6 +34,7 @@ func TestRoundtrips(t *tes.T) {
 		var parser = pac.Parser().Make()
 		var validator = pac.Validator().Make()
 		var formatter = pac.Formatter().Make()
+		var codec = pac.Codec().Make()
 		var filename = testDirectory + file.Name()
 		if sts.HasSuffix(filename, ".gomn") {
 			fmt.Println(filename)
@@ -45,6 +47,365 @@ func TestRoundtrips(t *tes.T) {
 			validator.ValidateModel(model)
 			var actual = formatter.FormatModel(model)
 			ass.Equal(t, expected, actual)
+
+			// The model must also survive a roundtrip through JSON.
+			var json = codec.ExportJSON(model)
+			actual = formatter.FormatModel(codec.ImportJSON(json))
+			ass.Equal(t, expected, actual)
 		}
 	}
 }
+
+func TestComparison(t *tes.T) {
+	var parser = pac.Parser().Make()
+	var comparator = pac.Comparator().Make()
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var source = string(bytes)
+	var original = parser.ParseSource(source)
+
+	// An unchanged model has no changes.
+	var changes = comparator.CompareModels(original, original)
+	ass.True(t, changes.GetSequence().IsEmpty())
+	ass.False(t, changes.HasBreakingChanges())
+
+	// Revise the model in both compatible and breaking ways.
+	source = sts.Replace(source, "\tKelvin\n", "\tKelvin\n\tRankine\n", 1)
+	source = sts.Replace(source, "DefaultCapacity() uint // = 16", "DefaultCapacity() uint // = 32", 1)
+	source = sts.Replace(source, "GetItem(index int) T", "GetItem(index uint) T", 1)
+	source = sts.Replace(source, "\tCloseQueue()\n", "", 1)
+	var revised = parser.ParseSource(source)
+	changes = comparator.CompareModels(original, revised)
+	ass.True(t, changes.HasBreakingChanges())
+	ass.Equal(t, 4, changes.GetSequence().GetSize())
+	var expected = `compatible added    UnitType.Rankine: The enumeration value was added.
+breaking   modified Sequential.GetItem: The parameters changed from (index int) to (index uint).
+compatible modified QueueClassLike.DefaultCapacity: The constant value changed from "16" to "32".
+breaking   removed  QueueLike.CloseQueue: The method was removed.
+`
+	ass.Equal(t, expected, changes.AsText())
+	ass.Contains(t, changes.AsJSON(), `"breaking": true`)
+}
+
+type methodCounter struct {
+	pac.WalkerLike
+	count int
+}
+
+func (v *methodCounter) EnterMethod(method pac.MethodLike) {
+	v.count++
+}
+
+func TestWalker(t *tes.T) {
+	var parser = pac.Parser().Make()
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var model = parser.ParseSource(string(bytes))
+	var counter = &methodCounter{WalkerLike: pac.Walker().Make()}
+	counter.Walk(model, counter)
+	ass.Equal(t, 11, counter.count)
+}
+
+func TestDiagrams(t *tes.T) {
+	var parser = pac.Parser().Make()
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var model = parser.ParseSource(string(bytes))
+	var mermaid = pac.Diagrammer().MakeWithFormat(pac.MermaidFormat)
+	ass.Contains(t, mermaid.DiagramModel(model), "QueueLike ..|> Sequential : implements")
+	var plantUML = pac.Diagrammer().MakeWithFormat(pac.PlantUMLFormat)
+	ass.Contains(t, plantUML.DiagramModel(model), "QueueClassLike ..> QueueLike : uses")
+	var dot = pac.Diagrammer().MakeWithFormat(pac.DOTFormat)
+	ass.Contains(t, dot.DiagramModel(model), `"QueueLike" -> "Synchronized"`)
+}
+
+func TestDocumentation(t *tes.T) {
+	var parser = pac.Parser().Make()
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var source = sts.Replace(
+		string(bytes),
+		"any type of item.",
+		"any type of <item> & more.",
+		1,
+	)
+	source = sts.Replace(source, "uint // = 16", "uint // = 1<<4 & 0xff", 1)
+	var model = parser.ParseSource(source)
+
+	// The Markdown pages link to each other and escape the comment text.
+	var markdown = pac.Documenter().MakeWithFormat(pac.MarkdownFormat)
+	var pages = markdown.DocumentModel(model)
+	var index = pages.GetValue("index.md")
+	ass.Contains(t, index, "# Package queues\n")
+	ass.Contains(t, index, "## Classes\n")
+	ass.Contains(t, index, "- [Queue](queue.md)\n")
+	ass.Contains(t, index, "any type of &lt;item&gt; &amp; more.")
+	var page = pages.GetValue("queue.md")
+	ass.Contains(t, page, "[Package queues](index.md)")
+	ass.Contains(t, page, "#### `MakeWithItems(items ...T) QueueLike[T]`\n")
+	ass.Contains(t, page, "Value: `1<<4 & 0xff`")
+
+	// The HTML pages link to each other and escape both comments and code.
+	var html = pac.Documenter().MakeWithFormat(pac.HTMLFormat)
+	pages = html.DocumentModel(model)
+	index = pages.GetValue("index.html")
+	ass.Contains(t, index, "<h1>Package queues</h1>\n")
+	ass.Contains(t, index, "<h2>Classes</h2>\n")
+	ass.Contains(t, index, `<li><a href="queue.html">Queue</a></li>`)
+	ass.Contains(t, index, "any type of &lt;item&gt; &amp; more.")
+	page = pages.GetValue("queue.html")
+	ass.Contains(t, page, `<a href="index.html">Package queues</a>`)
+	ass.Contains(t, page, "<h4><code>MakeWithItems(items ...T) QueueLike[T]</code></h4>\n")
+	ass.Contains(t, page, "Value: <code>1&lt;&lt;4 &amp; 0xff</code>")
+
+	// A single documenter may document several models concurrently.
+	var group syn.WaitGroup
+	for range 4 {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			ass.Equal(t, index, html.DocumentModel(model).GetValue("index.html"))
+		}()
+	}
+	group.Wait()
+}
+
+func TestLinting(t *tes.T) {
+	var filename = t.TempDir() + "/lint.rules"
+	var configuration = "# The enabled lint rules.\ncomment\nenumeration\n\nusage\n"
+	var err = osx.WriteFile(filename, []byte(configuration), 0644)
+	if err != nil {
+		panic(err)
+	}
+	var rules = pac.Validator().ReadRules(filename)
+	var validator = pac.Validator().MakeWithRules(rules...)
+	var bytes []byte
+	bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var source = string(bytes)
+	source = sts.Replace(source, "import ()", "import (\n\tfmt \"fmt\"\n)", 1)
+	source = sts.Replace(source, "ErrorUnit", "UnknownUnit", 1)
+	source = sts.Replace(source, "Item is a generic", "An item is a generic", 1)
+	source = sts.Replace(
+		source,
+		"UnitType is a specialized",
+		"nolint: comment, enumeration\nThe UnitType is a specialized",
+		1,
+	)
+	var parser = pac.Parser().Make()
+	var model = parser.ParseSource(source)
+	var diagnostics = validator.LintModel(model)
+	ass.Equal(t, 2, diagnostics.GetSize())
+	var iterator = diagnostics.GetIterator()
+	var diagnostic = iterator.GetNext()
+	ass.Equal(t, pac.UsageRule, diagnostic.GetRule())
+	ass.Equal(t, "fmt", diagnostic.GetPath())
+	diagnostic = iterator.GetNext()
+	ass.Equal(t, pac.CommentRule, diagnostic.GetRule())
+	ass.Equal(t, "Item", diagnostic.GetPath())
+
+	// Imports and functionals that are only used within a type argument, a
+	// constant annotation, a functional result or an aspect method are used.
+	source = string(bytes)
+	source = sts.Replace(
+		source,
+		"import ()",
+		`import (
+	ctx "context"
+	iox "io"
+	sts "strings"
+	tim "time"
+)`,
+		1,
+	)
+	source = sts.Replace(
+		source,
+		"// INTERFACES",
+		`/*
+RankingFunction defines the signature for any function that ranks an item.
+*/
+type RankingFunction func(item Item) int
+
+/*
+HashingFunction defines the signature for any function that hashes an item.
+*/
+type HashingFunction func(item Item) uint64
+
+/*
+FormattingFunction defines the signature for any function that formats an item.
+*/
+type FormattingFunction func(item Item) sts.Builder
+
+/*
+FactoryFunction defines the signature for any function that returns a formatter.
+*/
+type FactoryFunction func() FormattingFunction
+
+// INTERFACES`,
+		1,
+	)
+	source = sts.Replace(
+		source,
+		"\tDone()\n",
+		"\tDone()\n\tCancel(context ctx.Context, factory FactoryFunction)\n",
+		1,
+	)
+	source = sts.Replace(
+		source,
+		"DefaultCapacity() uint // = 16",
+		"DefaultCapacity() uint // = 16\n\tDefaultTimeout() int // = int(tim.Second)\n\tDefaultHasher() any // = HashingFunction(nil)",
+		1,
+	)
+	source = sts.Replace(
+		source,
+		"\tCloseQueue()\n",
+		"\tCloseQueue()\n\tGetReaders() Sequential[iox.Reader]\n\tGetRankers() Sequential[RankingFunction]\n",
+		1,
+	)
+	model = parser.ParseSource(source)
+	diagnostics = pac.Validator().MakeWithRules(pac.UsageRule).LintModel(model)
+	ass.Equal(t, 0, diagnostics.GetSize())
+}
+
+func TestResolution(t *tes.T) {
+	var bytes, err = osx.ReadFile(testDirectory + "queues.gomn")
+	if err != nil {
+		panic(err)
+	}
+	var source = string(bytes)
+	var parser = pac.Parser().Make()
+
+	// An abstraction must supply the type arguments that its declaration requires.
+	var model = parser.ParseSource(sts.Replace(source, "Make() QueueLike[T]", "Make() QueueLike", 1))
+	ass.PanicsWithValue(
+		t,
+		"The type QueueLike used in QueueClassLike.Make was given 0 type arguments but its declaration QueueLike[T] requires 1.",
+		func() { pac.Validator().Make().ValidateModel(model) },
+	)
+
+	// A type argument cannot be applied to a non-generic type.
+	model = parser.ParseSource(sts.Replace(source, "Add(delta int)", "Add(delta int[uint])", 1))
+	ass.PanicsWithValue(
+		t,
+		"The type int used in Synchronized.Add is not generic but was given 1 type arguments.",
+		func() { pac.Validator().Make().ValidateModel(model) },
+	)
+
+	// Every identifier must resolve to a known type.
+	model = parser.ParseSource(sts.Replace(source, "GetItem(index int) T", "GetItem(index int) V", 1))
+	ass.PanicsWithValue(
+		t,
+		"The type V used in Sequential.GetItem is not a builtin, type parameter, specialization, functional, aspect, class or instance.",
+		func() { pac.Validator().Make().ValidateModel(model) },
+	)
+
+	// Instance interfaces cannot embed each other in a cycle.
+	model = parser.ParseSource(sts.Replace(
+		source,
+		"\t// Methods\n\tGetNext() T",
+		"\t// Abstractions\n\tQueueLike[T]\n\n\t// Methods\n\tGetNext() T",
+		1,
+	))
+	ass.PanicsWithValue(
+		t,
+		"An instance interface cannot embed itself: IteratorLike -> QueueLike -> IteratorLike",
+		func() { pac.Validator().Make().ValidateModel(model) },
+	)
+	// Each method of an instance interface must be supplied exactly once.
+	model = parser.ParseSource(sts.Replace(source, "\tCloseQueue()\n", "\tCloseQueue()\n\tGetItem(index int) T\n", 1))
+	ass.PanicsWithValue(
+		t,
+		"The method GetItem of QueueLike is supplied by both Sequential[T] and its methods.",
+		func() { pac.Validator().Make().ValidateModel(model) },
+	)
+	model = parser.ParseSource(sts.Replace(source, "\tCloseQueue()\n", "\tCloseQueue()\n\tHasNext() int\n", 1))
+	ass.PanicsWithValue(
+		t,
+		"The method HasNext of QueueLike has conflicting signatures: HasNext() bool from IteratorLike[T] and HasNext() int from its methods.",
+		func() { pac.Validator().Make().ValidateModel(model) },
+	)
+}
+
+func TestImportValidation(t *tes.T) {
+	var codec = pac.Codec().Make()
+
+	// The header of a JSON model is required.
+	ass.PanicsWithValue(
+		t,
+		"The JSON model is missing the following required field: header",
+		func() { codec.ImportJSON(`{"version": "1.0", "notice": ""}`) },
+	)
+
+	// A missing declaration is reported with its path.
+	ass.PanicsWithValue(
+		t,
+		"The JSON model is missing the following required field: interfaces.classes[0].declaration",
+		func() {
+			codec.ImportJSON(`{
+				"version": "1.0",
+				"header": {"identifier": "example"},
+				"interfaces": {"classes": [{}]}
+			}`)
+		},
+	)
+
+	// A missing abstraction is reported with its path.
+	ass.PanicsWithValue(
+		t,
+		"The JSON model is missing the following required field: types.specializations[0].abstraction",
+		func() {
+			codec.ImportJSON(`{
+				"version": "1.0",
+				"header": {"identifier": "example"},
+				"types": {"specializations": [{
+					"declaration": {"identifier": "Name"}
+				}]}
+			}`)
+		},
+	)
+
+	// A missing parameter is reported with its path.
+	ass.PanicsWithValue(
+		t,
+		"The JSON model is missing the following required field: interfaces.classes[0].constructors[0].parameters[0]",
+		func() {
+			codec.ImportJSON(`{
+				"version": "1.0",
+				"header": {"identifier": "example"},
+				"interfaces": {"classes": [{
+					"declaration": {"identifier": "ExampleClassLike"},
+					"constructors": [{
+						"identifier": "MakeWithName",
+						"parameters": [null],
+						"abstraction": {"identifier": "ExampleLike"}
+					}]
+				}]}
+			}`)
+		},
+	)
+	ass.PanicsWithValue(
+		t,
+		"The JSON model is missing the following required field: types.specializations[0].enumeration.parameter",
+		func() {
+			codec.ImportJSON(`{
+				"version": "1.0",
+				"header": {"identifier": "example"},
+				"types": {"specializations": [{
+					"declaration": {"identifier": "Color"},
+					"abstraction": {"identifier": "uint8"},
+					"enumeration": {"values": ["Blue"]}
+				}]}
+			}`)
+		},
+	)
+}
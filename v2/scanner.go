@@ -25,6 +25,7 @@ import (
 
 var scannerClass = &scannerClass_{
 	matchers_: map[TokenType]*reg.Regexp{
+		AnnotationToken: reg.MustCompile(`^(?:` + annotation_ + `)`),
 		CommentToken:    reg.MustCompile(`^(?:` + comment_ + `)`),
 		DelimiterToken:  reg.MustCompile(`^(?:` + delimiter_ + `)`),
 		IdentifierToken: reg.MustCompile(`^(?:` + identifier_ + `)`),
@@ -162,6 +163,7 @@ func (v *scanner_) scanTokens() {
 loop:
 	for v.next_ < len(v.runes_) {
 		switch {
+		case v.foundToken(AnnotationToken):
 		case v.foundToken(CommentToken):
 		case v.foundToken(DelimiterToken):
 		case v.foundToken(IdentifierToken):
@@ -185,10 +187,11 @@ in this way.  We append an underscore to each name to lessen the chance of a
 name collision with other private Go class constants in this package.
 */
 const (
+	annotation_ = `\/\/ = [^` + control_ + `]+`
 	any_        = `.|\n`
-	comment_    = `/\*\n((?:` + any_ + `)*?)\n\*/[\n]+`
+	comment_    = `/\*\n((?:` + any_ + `)*?)\n[ \t]*\*/[\n]+`
 	control_    = `\p{Cc}`
-	delimiter_  = `[[\](){}\.,=]`
+	delimiter_  = `\.\.\.|[[\](){}\.,=]`
 	digit_      = `\p{Nd}`
 	identifier_ = `(?:` + letter_ + `)(?:` + letter_ + `|` + digit_ + `)*`
 	letter_     = lower_ + `|` + upper_ + `|_`
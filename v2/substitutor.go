@@ -0,0 +1,156 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+import (
+	col "github.com/craterdog/go-collection-framework/v3"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var substitutorClass = &substitutorClass_{
+	// This class does not initialize any class constants.
+}
+
+// Function
+
+func Substitutor() SubstitutorClassLike {
+	return substitutorClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type substitutorClass_ struct {
+	// This class does not define any class constants.
+}
+
+// Constructors
+
+func (c *substitutorClass_) MakeWithTypes(
+	genericTypes ParametersLike,
+	concreteTypes ArgumentsLike,
+) SubstitutorLike {
+	return &substitutor_{
+		genericTypes_:  genericTypes,
+		concreteTypes_: concreteTypes,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type substitutor_ struct {
+	genericTypes_  ParametersLike
+	concreteTypes_ ArgumentsLike
+}
+
+// Attributes
+
+func (v *substitutor_) GetGenericTypes() ParametersLike {
+	return v.genericTypes_
+}
+
+func (v *substitutor_) GetConcreteTypes() ArgumentsLike {
+	return v.concreteTypes_
+}
+
+// Public
+
+func (v *substitutor_) SubstituteAbstraction(abstraction AbstractionLike) AbstractionLike {
+	if v.genericTypes_ == nil || v.concreteTypes_ == nil {
+		// There is nothing to substitute.
+		return abstraction
+	}
+	var formatter = Formatter().Make()
+	var identifier = abstraction.GetIdentifier()
+	var genericIterator = v.genericTypes_.GetSequence().GetIterator()
+	var concreteIterator = v.concreteTypes_.GetSequence().GetIterator()
+	for genericIterator.HasNext() && concreteIterator.HasNext() {
+		var genericName = genericIterator.GetNext().GetIdentifier()
+		var concreteType = concreteIterator.GetNext()
+		if identifier == genericName {
+			identifier = formatter.FormatAbstraction(concreteType)
+			break
+		}
+	}
+	var arguments = abstraction.GetArguments()
+	if arguments != nil {
+		var sequence = col.List[AbstractionLike]().Make()
+		var iterator = arguments.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var argument = v.SubstituteAbstraction(iterator.GetNext())
+			sequence.AppendValue(argument)
+		}
+		arguments = Arguments().MakeWithAttributes(sequence)
+	}
+	abstraction = Abstraction().MakeWithAttributes(
+		abstraction.GetPrefix(),
+		identifier,
+		arguments,
+	)
+	return abstraction
+}
+
+func (v *substitutor_) SubstituteMethod(method MethodLike) MethodLike {
+	var parameters = method.GetParameters()
+	if parameters != nil {
+		parameters = v.SubstituteParameters(parameters)
+	}
+	var result = method.GetResult()
+	if result != nil {
+		result = v.SubstituteResult(result)
+	}
+	method = Method().MakeWithAttributes(
+		method.GetComment(),
+		method.GetIdentifier(),
+		parameters,
+		result,
+	)
+	return method
+}
+
+func (v *substitutor_) SubstituteParameters(parameters ParametersLike) ParametersLike {
+	var sequence = col.List[ParameterLike]().Make()
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		var abstraction = v.SubstituteAbstraction(parameter.GetAbstraction())
+		parameter = Parameter().MakeWithAttributes(
+			parameter.GetIdentifier(),
+			parameter.IsVariadic(),
+			abstraction,
+		)
+		sequence.AppendValue(parameter)
+	}
+	parameters = Parameters().MakeWithAttributes(sequence)
+	return parameters
+}
+
+func (v *substitutor_) SubstituteResult(result ResultLike) ResultLike {
+	var abstraction = result.GetAbstraction()
+	if abstraction != nil {
+		abstraction = v.SubstituteAbstraction(abstraction)
+		result = Result().MakeWithAbstraction(abstraction)
+	} else {
+		var parameters = v.SubstituteParameters(result.GetParameters())
+		result = Result().MakeWithParameters(parameters)
+	}
+	return result
+}
+
+// Private
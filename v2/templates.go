@@ -19,6 +19,18 @@ const headerTemplate_ = `package <PackageName>`
 const importsTemplate_ = `
 import (<Modules>)`
 
+// These are the modules that the templates themselves reference, keyed by the
+// alias that the templates use for each module.
+var templateModules_ = map[string]string{
+	"fmt": `"fmt"`,
+	"cdc": `"github.com/craterdog/go-collection-framework/v3/cdcn"`,
+	"col": `"github.com/craterdog/go-collection-framework/v3"`,
+	"jsn": `"encoding/json"`,
+	"ref": `"reflect"`,
+	"syn": `"sync"`,
+	"tim": `"time"`,
+}
+
 const classAccessTemplate_ = `
 // CLASS ACCESS
 
@@ -26,16 +38,17 @@ const classAccessTemplate_ = `
 <Reference>
 
 // Function
-<Function>`
+<Function>
+
+// Compliance
+<Compliance>`
 
 const classReferenceTemplate_ = `
-var <TargetName>Class = &<TargetName>Class_{
-	// TBA - Assign constant values.
+var <TargetName>Class = &<TargetName>Class_{<Values>
 }`
 
 const genericReferenceTemplate_ = `
-var <TargetName>Class = map[string]any{}
-var <TargetName>Mutex syn.Mutex`
+var <TargetName>Class syn.Map`
 
 const classFunctionTemplate_ = `
 func <ClassName>() <ClassName>ClassLike {
@@ -44,30 +57,39 @@ func <ClassName>() <ClassName>ClassLike {
 
 const genericFunctionTemplate_ = `
 func <ClassName>[<Parameters>]() <ClassName>ClassLike[<Arguments>] {
-	// Generate the name of the bound class type.
-	var result_ <ClassName>ClassLike[<Arguments>]
-	var name = fmt.Sprintf("%T", result_)
+	// Each bound class type is keyed by a typed nil pointer which is unique to
+	// that bound class type and requires no formatting or allocation.
+	var key *<TargetName>Class_[<Arguments>]
 
 	// Check for existing bound class type.
-	<TargetName>Mutex.Lock()
-	var value = <TargetName>Class[name]
-	switch actual := value.(type) {
-	case *<TargetName>Class_[<Arguments>]:
-		// This bound class type already exists.
-		result_ = actual
-	default:
+	var value, ok = <TargetName>Class.Load(key)
+	if !ok {
 		// Add a new bound class type.
-		result_ = &<TargetName>Class_[<Arguments>]{
-			// TBA - Assign constant values.
-		}
-		<TargetName>Class[name] = result_
+		value, _ = <TargetName>Class.LoadOrStore(key, &<TargetName>Class_[<Arguments>]{<Values>
+		})
 	}
-	<TargetName>Mutex.Unlock()
 
 	// Return a reference to the bound class type.
-	return result_
+	return value.(*<TargetName>Class_[<Arguments>])
+}`
+
+const complianceTemplate_ = `
+var _ <ClassName>ClassLike = (*<TargetName>Class_)(nil)<Instance>`
+
+const instanceComplianceTemplate_ = `
+var _ <ClassName>Like = (*<TargetName>_)(nil)`
+
+const genericComplianceTemplate_ = `
+func _[<Parameters>]() {
+	var _ <ClassName>ClassLike[<Arguments>] = (*<TargetName>Class_[<Arguments>])(nil)<Instance>
 }`
 
+const genericInstanceComplianceTemplate_ = `
+	var _ <ClassName>Like[<Arguments>] = (*<TargetName>_[<Arguments>])(nil)`
+
+const constantValueTemplate_ = `
+	<ConstantName>_: <ConstantValue>,`
+
 const classMethodsTemplate_ = `
 // CLASS METHODS
 
@@ -87,16 +109,21 @@ const classConstantTemplate_ = `
 	<ConstantName>_ <ConstantType>`
 
 const classMethodTemplate_ = `
-func (c *<TargetName>Class_[<Arguments>]) <MethodName>(<Parameters>)<ResultType> {<Body>}`
+<Comment>func (c *<TargetName>Class_[<Arguments>]) <MethodName>(<Parameters>)<ResultType> {<Body>}`
 
 const constantBodyTemplate_ = `
 	return c.<ConstantName>_
 `
 
-const constructorBodyTemplate_ = `
+const constructorBodyTemplate_ = `<Checks>
 	return &<TargetName>_[<Arguments>]{<Assignments>}
 `
 
+const nilCheckTemplate_ = `
+	if <ParameterName> == nil {
+		panic("The <ParameterName> argument to <ClassName>.<MethodName>() cannot be nil.")
+	}`
+
 const attributeAssignmentTemplate_ = `
 		<AttributeName>_: <ParameterName>,`
 
@@ -114,7 +141,7 @@ const instanceMethodsTemplate_ = `
 // Attributes
 <Attributes><Abstractions>
 // Public
-<Methods>
+<Methods><ValueMethods><SerializationMethods>
 // Private
 `
 
@@ -129,7 +156,214 @@ const instanceAspectTemplate_ = `
 <Methods>`
 
 const instanceMethodTemplate_ = `
-func (v *<TargetName>_[<Arguments>]) <MethodName>(<Parameters>)<ResultType> {<Body>}`
+<Comment>func (v *<TargetName>_[<Arguments>]) <MethodName>(<Parameters>)<ResultType> {<Body>}`
+
+const valueMethodsTemplate_ = `
+// Value
+<Methods>`
+
+const equalMethodTemplate_ = `
+func (v *<TargetName>_[<Arguments>]) Equal(other any) bool {
+	var that, ok = other.(*<TargetName>_[<Arguments>])
+	switch {
+	case !ok:
+		return false
+	case v == that:
+		return true
+	case v == nil || that == nil:
+		return false
+	}<Comparisons>
+	return true
+}
+`
+
+const valueComparisonTemplate_ = `
+	if <Different> {
+		return false
+	}`
+
+const sliceComparisonTemplate_ = `
+	if len(v.<FieldName>) != len(that.<FieldName>) {
+		return false
+	}
+	for index, value := range v.<FieldName> {
+		if <Different> {
+			return false
+		}
+	}`
+
+const mapComparisonTemplate_ = `
+	if len(v.<FieldName>) != len(that.<FieldName>) {
+		return false
+	}
+	for key, value := range v.<FieldName> {
+		var thatValue, ok = that.<FieldName>[key]
+		if !ok || <Different> {
+			return false
+		}
+	}`
+
+const functionalComparisonTemplate_ = `
+	// Functions cannot be compared so only their presence is compared.
+	if (v.<FieldName> == nil) != (that.<FieldName> == nil) {
+		return false
+	}`
+
+const sequenceComparisonTemplate_ = `
+	if (v.<FieldName> == nil) != (that.<FieldName> == nil) {
+		return false
+	}
+	if v.<FieldName> != nil {
+		var values = v.<FieldName>.AsArray()
+		var thatValues = that.<FieldName>.AsArray()
+		if len(values) != len(thatValues) {
+			return false
+		}
+		for index, value := range values {
+			if <Different> {
+				return false
+			}
+		}
+	}`
+
+const copyMethodTemplate_ = `
+func (v *<TargetName>_[<Arguments>]) Copy() <ClassName>Like[<Arguments>] {
+	// Any attribute values other than slices, maps and sequences are shared by
+	// the copy.
+	var copy_ = *v<Copies>
+	return &copy_
+}
+`
+
+const sliceCopyTemplate_ = `
+	if v.<FieldName> != nil {
+		copy_.<FieldName> = make(<FieldType>, len(v.<FieldName>))
+		for index, value := range v.<FieldName> {
+			copy_.<FieldName>[index] = value
+		}
+	}`
+
+const mapCopyTemplate_ = `
+	if v.<FieldName> != nil {
+		copy_.<FieldName> = make(<FieldType>, len(v.<FieldName>))
+		for key, value := range v.<FieldName> {
+			copy_.<FieldName>[key] = value
+		}
+	}`
+
+const sequenceCopyTemplate_ = `
+	if v.<FieldName> != nil {
+		copy_.<FieldName> = <Alias>.List[<ValueType>]().MakeFromSequence(v.<FieldName>)
+	}`
+
+const stringMethodTemplate_ = `
+func (v *<TargetName>_[<Arguments>]) String() string {
+	var result = "<ClassName>{"<Strings>
+	result += "}"
+	return result
+}
+`
+
+const valueStringTemplate_ = `
+	result += fmt.Sprintf("<Separator><AttributeName>: %v", v.<FieldName>)`
+
+const sequenceStringTemplate_ = `
+	if v.<FieldName> == nil {
+		result += "<Separator><AttributeName>: <nil>"
+	} else {
+		result += fmt.Sprintf("<Separator><AttributeName>: %v", v.<FieldName>.AsArray())
+	}`
+
+const serializationMethodsTemplate_ = `
+// Serialization
+<Methods>`
+
+const marshalMethodTemplate_ = `
+func (v *<TargetName>_[<Arguments>]) MarshalJSON() ([]byte, error) {
+	var attributes_ = map[string]any{<Encodings>
+	}
+	return jsn.Marshal(attributes_)
+}
+`
+
+const attributeEncodingTemplate_ = `
+		"<AttributeName>": v.<AttributeName>_,`
+
+const unmarshalMethodTemplate_ = `
+func (v *<TargetName>_[<Arguments>]) UnmarshalJSON(data_ []byte) error {
+	var attributes_ map[string]jsn.RawMessage
+	var err = jsn.Unmarshal(data_, &attributes_)
+	if err != nil {
+		return err
+	}
+<Decodings>
+	// Reconstruct the instance using its class constructor.
+	var instance_ = <ClassName>[<Arguments>]().MakeWithAttributes(<ParameterNames>)
+	*v = *instance_.(*<TargetName>_[<Arguments>])
+	return nil
+}
+`
+
+const attributeDecodingTemplate_ = `
+	var <ParameterName> <ParameterType>
+	if value_, ok := attributes_["<AttributeName>"]; ok {
+		err = jsn.Unmarshal(value_, &<ParameterName>)
+		if err != nil {
+			return err
+		}
+	}
+`
+
+const marshalNotationTemplate_ = `
+func (v *<TargetName>_[<Arguments>]) MarshalCDCN() ([]byte, error) {
+	var attributes_ = col.Catalog[string, any]().Make()
+	var value_ any
+	var err error<Encodings>
+	var notation_ = cdc.Notation().Make()
+	return []byte(notation_.FormatCollection(attributes_)), nil
+}
+`
+
+const attributeNotationEncodingTemplate_ = `
+	value_, err = encodeCDCN(v.<AttributeName>_)
+	if err != nil {
+		return nil, err
+	}
+	attributes_.SetValue("<AttributeName>", value_)`
+
+const unmarshalNotationTemplate_ = `
+func (v *<TargetName>_[<Arguments>]) UnmarshalCDCN(data_ []byte) (err error) {
+	// The notation panics if the source is not valid CDCN.
+	defer func() {
+		var exception_ = recover()
+		if exception_ != nil {
+			err = fmt.Errorf("%v", exception_)
+		}
+	}()
+	var notation_ = cdc.Notation().Make()
+	var collection_ = notation_.ParseSource(string(data_))
+	var attributes_, ok = collection_.(col.CatalogLike[col.Key, col.Value])
+	if !ok {
+		err = fmt.Errorf("The CDCN source does not contain a catalog of attributes.")
+		return err
+	}
+	var value_ col.Value
+<Decodings>
+	// Reconstruct the instance using its class constructor.
+	var instance_ = <ClassName>[<Arguments>]().MakeWithAttributes(<ParameterNames>)
+	*v = *instance_.(*<TargetName>_[<Arguments>])
+	return nil
+}
+`
+
+const attributeNotationDecodingTemplate_ = `
+	var <ParameterName> <ParameterType>
+	value_ = attributes_.GetValue("<AttributeName>")
+	err = decodeCDCN(value_, ref.ValueOf(&<ParameterName>).Elem())
+	if err != nil {
+		return err
+	}
+`
 
 const methodBodyTemplate_ = `
 	// TBA - Implement the method.
@@ -154,6 +388,470 @@ const setterBodyTemplate_ = `
 	v.<AttributeName>_ = <ParameterName>
 `
 
+const mockTemplate_ = `<Notice>package mocks
+
+import (
+	fmt "fmt"
+	ref "reflect"
+	sts "strings"
+	syn "sync"
+)
+
+/*
+Call captures the name of a mocked method and the arguments that were passed
+into it.
+*/
+type Call struct {
+	Method    string
+	Arguments []any
+}
+
+func (v Call) String() string {
+	var arguments []string
+	for _, argument := range v.Arguments {
+		arguments = append(arguments, fmt.Sprintf("%#v", argument))
+	}
+	return v.Method + "(" + sts.Join(arguments, ", ") + ")"
+}
+
+/*
+Reporter defines the subset of the testing.TB interface that a mock uses to
+report any unmet expectations.
+*/
+type Reporter interface {
+	Helper()
+	Errorf(format string, arguments ...any)
+}
+
+/*
+Mocked is implemented by each of the generated mocks.  Its only method is
+unexported so that it cannot collide with any method of a mocked interface.
+*/
+type Mocked interface {
+	mock() *Mock
+}
+
+/*
+Control returns the Mock that records the calls that are made to the specified
+mock and stubs the results of its methods.
+*/
+func Control(mocked Mocked) *Mock {
+	return mocked.mock()
+}
+
+/*
+Mock records each call that is made to a mocked method along with its arguments,
+returns the results that were stubbed for that method, and verifies that each
+expected call was made.  It is held by each of the generated mocks rather than
+embedded in them so that its methods cannot collide with those of a mocked
+interface, and its zero value is ready to use.
+*/
+type Mock struct {
+	mutex_        syn.Mutex
+	calls_        []Call
+	stubs_        map[string][]any
+	expectations_ []Call
+}
+
+func (v *Mock) Expect(method string, arguments ...any) {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	var expectation = Call{Method: method, Arguments: arguments}
+	v.expectations_ = append(v.expectations_, expectation)
+}
+
+func (v *Mock) GetCalls(method string) []Call {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	var calls []Call
+	for _, call := range v.calls_ {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (v *Mock) Record(method string, arguments ...any) []any {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	var call = Call{Method: method, Arguments: arguments}
+	v.calls_ = append(v.calls_, call)
+	return v.stubs_[method]
+}
+
+func (v *Mock) Stub(method string, results ...any) {
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	if v.stubs_ == nil {
+		v.stubs_ = make(map[string][]any)
+	}
+	v.stubs_[method] = results
+}
+
+func (v *Mock) Verify(reporter Reporter) {
+	reporter.Helper()
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	for _, expectation := range v.expectations_ {
+		if !v.wasCalled(expectation) {
+			reporter.Errorf("The expected call was not made: %v", expectation)
+		}
+	}
+}
+
+func (v *Mock) wasCalled(expectation Call) bool {
+	for _, call := range v.calls_ {
+		if call.Method == expectation.Method &&
+			ref.DeepEqual(call.Arguments, expectation.Arguments) {
+			return true
+		}
+	}
+	return false
+}
+`
+
+const mockClassTemplate_ = `<Notice><Header><Imports>
+/*
+Mock<MockName> is a mock implementation of the <InterfaceName> interface.
+Each call to one of its methods is recorded and returns the results that were
+stubbed for that method, if any.  A stubbed result must either be nil or have
+the exact type that the method returns, otherwise the call panics.  The calls
+are stubbed and verified using the Mock that is returned by Control.
+*/
+type Mock<MockName>[<Parameters>] struct {
+	mock_ Mock
+}
+
+func (v *Mock<MockName>[<Arguments>]) mock() *Mock {
+	return &v.mock_
+}
+<Methods>`
+
+const mockMethodTemplate_ = `
+func (v *Mock<MockName>[<Arguments>]) <MethodName>(<Parameters>)<ResultType> {<Body>}
+`
+
+const mockCallBodyTemplate_ = `
+	v.mock_.Record("<MethodName>"<ArgumentNames>)
+`
+
+const mockResultBodyTemplate_ = `
+	var results_ = v.mock_.Record("<MethodName>"<ArgumentNames>)
+	var result_ <ResultType>
+	if len(results_) > 0 && results_[0] != nil {
+		var ok_ bool
+		result_, ok_ = results_[0].(<ResultType>)
+		if !ok_ {
+			panic("The result stubbed for Mock<MockName>.<MethodName> must have the type <ResultType>.")
+		}
+	}
+	return result_
+`
+
+const mockReturnBodyTemplate_ = `
+	var results_ = v.mock_.Record("<MethodName>"<ArgumentNames>)<Results>
+	return
+`
+
+const mockResultTemplate_ = `
+	if len(results_) > <Index> && results_[<Index>] != nil {
+		var ok_ bool
+		<ResultName>, ok_ = results_[<Index>].(<ResultType>)
+		if !ok_ {
+			panic("The <ResultName> result stubbed for Mock<MockName>.<MethodName> must have the type <ResultType>.")
+		}
+	}`
+
+const synchronizedTemplate_ = `<Notice><Header><Imports><Wrappers>`
+
+const synchronizedWrapperTemplate_ = `
+/*
+Synchronized<ClassName> returns a wrapper around the specified <ClassName>Like
+instance that guards each of its attributes and methods with a read-write mutex
+so that it may be shared safely across goroutines.
+*/
+func Synchronized<ClassName>[<Parameters>](
+	delegate <ClassName>Like[<Arguments>],
+) <ClassName>Like[<Arguments>] {
+	return &synchronized<ClassName>_[<Arguments>]{
+		delegate_: delegate,
+	}
+}
+
+type synchronized<ClassName>_[<Parameters>] struct {
+	mutex_    syn.RWMutex
+	delegate_ <ClassName>Like[<Arguments>]
+}
+<Methods>`
+
+const synchronizedMethodTemplate_ = `
+func (v *synchronized<ClassName>_[<Arguments>]) <MethodName>(<Parameters>)<ResultType> {
+	v.mutex_.<Lock>()
+	defer v.mutex_.<Unlock>()<Body>}
+`
+
+const synchronizedCallBodyTemplate_ = `
+	v.delegate_.<MethodName>(<ArgumentNames>)
+`
+
+const synchronizedReturnBodyTemplate_ = `
+	return v.delegate_.<MethodName>(<ArgumentNames>)
+`
+
+const instrumentedTemplate_ = `<Notice><Header><Imports>
+/*
+CallObserver defines the method that must be supported by each observer of the
+calls made through an instrumented wrapper.  Any panic raised by a call is
+passed to the observer as the recovered value and then raised again with that
+same value.  Since the panic is recovered, the stack trace of the panic that is
+raised again starts at the wrapper and the original stack is lost.  An observer
+that needs the original stack can capture it with runtime/debug.Stack since it
+is called before the stack of the panicking call has been unwound.
+*/
+type CallObserver interface {
+	ObserveCall(
+		className string,
+		methodName string,
+		arguments []any,
+		results []any,
+		recovered any,
+		duration tim.Duration,
+	)
+}
+<Wrappers>`
+
+const instrumentedWrapperTemplate_ = `
+/*
+Instrumented<ClassName> returns a wrapper around the specified <ClassName>Like
+instance that reports each call to its attributes and methods, along with its
+arguments, results, any panic and its duration, to the specified observer.
+*/
+func Instrumented<ClassName>[<Parameters>](
+	delegate <ClassName>Like[<Arguments>],
+	observer CallObserver,
+) <ClassName>Like[<Arguments>] {
+	return &instrumented<ClassName>_[<Arguments>]{
+		delegate_: delegate,
+		observer_: observer,
+	}
+}
+
+type instrumented<ClassName>_[<Parameters>] struct {
+	delegate_ <ClassName>Like[<Arguments>]
+	observer_ CallObserver
+}
+<Methods>`
+
+const instrumentedMethodTemplate_ = `
+func (v *instrumented<ClassName>_[<Arguments>]) <MethodName>(<Parameters>)<ResultType> {
+	var start_ = tim.Now()
+	defer func() {
+		var recovered_ = recover()
+		if recovered_ != nil {
+			// The original value is raised again even if the observer panics.
+			defer panic(recovered_)
+		}
+		v.observer_.ObserveCall(
+			"<ClassName>",
+			"<MethodName>",
+			[]any{<ArgumentValues>},
+			[]any{<ResultValues>},
+			recovered_,
+			tim.Since(start_),
+		)
+	}()<Body>}
+`
+
+const instrumentedCallBodyTemplate_ = `
+	v.delegate_.<MethodName>(<ArgumentNames>)
+`
+
+const instrumentedReturnBodyTemplate_ = `
+	<ResultValues> = v.delegate_.<MethodName>(<ArgumentNames>)
+	return
+`
+
+const serializationTemplate_ = `<Notice><Header><Imports><Specializations><Functions>`
+
+const specializationSerializationTemplate_ = `
+func (v <SpecializationName>) MarshalJSON() ([]byte, error) {
+	var name, err = v.encodeName()
+	if err != nil {
+		return nil, err
+	}
+	return jsn.Marshal(name)
+}
+
+func (v *<SpecializationName>) UnmarshalJSON(data_ []byte) error {
+	var name string
+	var err = jsn.Unmarshal(data_, &name)
+	if err != nil {
+		return err
+	}
+	return v.decodeName(name)
+}
+
+func (v <SpecializationName>) encodeCDCN() (any, error) {
+	return v.encodeName()
+}
+
+func (v *<SpecializationName>) decodeCDCN(value_ any) error {
+	var name, ok = value_.(string)
+	if !ok {
+		var err = fmt.Errorf("The value %v is not the name of a <SpecializationName>.", value_)
+		return err
+	}
+	return v.decodeName(name)
+}
+
+func (v <SpecializationName>) encodeName() (string, error) {
+	var name string
+	switch v {<NameCases>
+	default:
+		var err = fmt.Errorf("The value %v is not a valid <SpecializationName>.", v)
+		return name, err
+	}
+	return name, nil
+}
+
+func (v *<SpecializationName>) decodeName(name string) error {
+	switch name {<ValueCases>
+	default:
+		var err = fmt.Errorf("The name %q is not a valid <SpecializationName>.", name)
+		return err
+	}
+	return nil
+}
+`
+
+const notationFunctionsTemplate_ = `
+/*
+encodeCDCN converts the specified value into the primitive values, arrays and
+maps that can be formatted using Crater Dog Collection Notation™ (CDCN).  The
+values of an enumerated specialization are encoded using their names.
+*/
+func encodeCDCN(value_ any) (any, error) {
+	var encoder_, ok = value_.(interface{ encodeCDCN() (any, error) })
+	if ok {
+		return encoder_.encodeCDCN()
+	}
+	var reflected_ = ref.ValueOf(value_)
+	switch reflected_.Kind() {
+	case ref.Bool:
+		return reflected_.Bool(), nil
+	case ref.Int, ref.Int8, ref.Int16, ref.Int32, ref.Int64:
+		// A rune is encoded as an integer since it may hold any int32 value.
+		return reflected_.Int(), nil
+	case ref.Uint, ref.Uint8, ref.Uint16, ref.Uint32, ref.Uint64, ref.Uintptr:
+		return reflected_.Uint(), nil
+	case ref.Float32, ref.Float64:
+		return reflected_.Float(), nil
+	case ref.Complex64, ref.Complex128:
+		return reflected_.Complex(), nil
+	case ref.String:
+		return reflected_.String(), nil
+	case ref.Array, ref.Slice:
+		// A nil slice is encoded as an empty array.
+		var values_ = make([]any, reflected_.Len())
+		for index_ := range values_ {
+			var value_, err = encodeCDCN(reflected_.Index(index_).Interface())
+			if err != nil {
+				return nil, err
+			}
+			values_[index_] = value_
+		}
+		return values_, nil
+	case ref.Map:
+		// A nil map is encoded as an empty map.
+		var associations_ = make(map[any]any, reflected_.Len())
+		var iterator_ = reflected_.MapRange()
+		for iterator_.Next() {
+			var key_, err = encodeCDCN(iterator_.Key().Interface())
+			if err != nil {
+				return nil, err
+			}
+			var value_ any
+			value_, err = encodeCDCN(iterator_.Value().Interface())
+			if err != nil {
+				return nil, err
+			}
+			associations_[key_] = value_
+		}
+		return associations_, nil
+	default:
+		var err = fmt.Errorf("The value %v of type %T cannot be encoded using CDCN.", value_, value_)
+		return nil, err
+	}
+}
+
+/*
+decodeCDCN sets the specified target to the value that was parsed from Crater
+Dog Collection Notation™ (CDCN), converting any numbers to the type of the
+target.
+*/
+func decodeCDCN(value_ any, target_ ref.Value) error {
+	var decoder_, ok = target_.Addr().Interface().(interface{ decodeCDCN(value_ any) error })
+	if ok {
+		return decoder_.decodeCDCN(value_)
+	}
+	if value_ == nil {
+		// A missing attribute is left with its zero value.
+		target_.SetZero()
+		return nil
+	}
+	var reflected_ = ref.ValueOf(value_)
+	switch {
+	case target_.Kind() == ref.Slice && reflected_.Kind() == ref.Slice:
+		var slice_ = ref.MakeSlice(target_.Type(), reflected_.Len(), reflected_.Len())
+		for index_ := 0; index_ < reflected_.Len(); index_++ {
+			var err = decodeCDCN(reflected_.Index(index_).Interface(), slice_.Index(index_))
+			if err != nil {
+				return err
+			}
+		}
+		target_.Set(slice_)
+	case target_.Kind() == ref.Map && reflected_.Kind() == ref.Map:
+		var map_ = ref.MakeMapWithSize(target_.Type(), reflected_.Len())
+		var iterator_ = reflected_.MapRange()
+		for iterator_.Next() {
+			var key_ = ref.New(target_.Type().Key()).Elem()
+			var err = decodeCDCN(iterator_.Key().Interface(), key_)
+			if err != nil {
+				return err
+			}
+			var element_ = ref.New(target_.Type().Elem()).Elem()
+			err = decodeCDCN(iterator_.Value().Interface(), element_)
+			if err != nil {
+				return err
+			}
+			map_.SetMapIndex(key_, element_)
+		}
+		target_.Set(map_)
+	case reflected_.Type().AssignableTo(target_.Type()):
+		target_.Set(reflected_)
+	case (reflected_.Kind() == ref.String) != (target_.Kind() == ref.String):
+		// A number must never be converted into a string or vice versa.
+		var err = fmt.Errorf("The value %v cannot be decoded as a %v.", value_, target_.Type())
+		return err
+	case reflected_.CanConvert(target_.Type()):
+		target_.Set(reflected_.Convert(target_.Type()))
+	default:
+		var err = fmt.Errorf("The value %v cannot be decoded as a %v.", value_, target_.Type())
+		return err
+	}
+	return nil
+}
+`
+
+const nameCaseTemplate_ = `
+	case <Value>:
+		name = "<Value>"`
+
+const valueCaseTemplate_ = `
+	case "<Value>":
+		*v = <Value>`
+
 const modelTemplate_ = `
 /*
 ................................................................................
@@ -270,3 +968,103 @@ type <ClassName>Like interface {
 }
 ...
 `
+
+/*
+The following private types define the data model that is passed to the
+"class.tmpl" template of a user supplied template set, it is documented by the
+MakeWithTemplates() constructor.  The built-in templates are not rendered from
+this data, but the fields, assignments, defaults, nil checks, value methods and
+serialization methods of a class are extracted by the same generator methods for
+both so the two never disagree about them.
+*/
+
+type classData_ struct {
+	Notice       string
+	Package      string
+	Name         string
+	Target       string
+	Parameters   string
+	Arguments    string
+	Comment      string
+	Constants    []constantData_
+	Constructors []methodData_
+	Functions    []methodData_
+	Instance     *instanceData_
+}
+
+type constantData_ struct {
+	Name    string
+	Field   string
+	Type    string
+	Value   string
+	Comment string
+}
+
+type instanceData_ struct {
+	Name          string
+	Comment       string
+	Fields        []fieldData_
+	Attributes    []attributeData_
+	Abstractions  []abstractionData_
+	Methods       []methodData_
+	Value         *valueData_
+	Serialization *serializationData_
+}
+
+type fieldData_ struct {
+	Name  string
+	Type  string
+	Value string
+}
+
+type attributeData_ struct {
+	Name      string
+	Field     string
+	Parameter string
+	Argument  string
+	Result    string
+	Default   string
+	Comment   string
+}
+
+type abstractionData_ struct {
+	Name    string
+	Methods []methodData_
+}
+
+type methodData_ struct {
+	Name        string
+	Parameters  string
+	Arguments   string
+	Result      string
+	IsNamed     bool
+	Assignments []fieldData_
+	Checks      []string
+	Comment     string
+}
+
+type valueData_ struct {
+	Methods []string
+	Fields  []valueFieldData_
+}
+
+type valueFieldData_ struct {
+	Name         string
+	Type         string
+	Kind         string
+	ElementType  string
+	IsComparable bool
+	Alias        string
+}
+
+type serializationData_ struct {
+	Methods    []string
+	Parameters []parameterData_
+}
+
+type parameterData_ struct {
+	Name       string
+	Field      string
+	Type       string
+	IsVariadic bool
+}
@@ -23,6 +23,7 @@ import (
 var tokenClass = &tokenClass_{
 	strings_: map[TokenType]string{
 		ErrorToken:      "Error",
+		AnnotationToken: "Annotation",
 		CommentToken:    "Comment",
 		DelimiterToken:  "Delimiter",
 		EOFToken:        "EOF",
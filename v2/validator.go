@@ -15,6 +15,8 @@ package packages
 import (
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v3"
+	osx "os"
+	reg "regexp"
 	sts "strings"
 )
 
@@ -23,7 +25,30 @@ import (
 // Reference
 
 var validatorClass = &validatorClass_{
-	// This class does not initialize any class constants.
+	builtins_: col.Set[string]().MakeFromArray([]string{
+		"any",
+		"bool",
+		"byte",
+		"comparable",
+		"complex128",
+		"complex64",
+		"error",
+		"float32",
+		"float64",
+		"int",
+		"int16",
+		"int32",
+		"int64",
+		"int8",
+		"rune",
+		"string",
+		"uint",
+		"uint16",
+		"uint32",
+		"uint64",
+		"uint8",
+		"uintptr",
+	}),
 }
 
 // Function
@@ -37,13 +62,22 @@ func Validator() ValidatorClassLike {
 // Target
 
 type validatorClass_ struct {
-	// This class does not define any class constants.
+	builtins_ col.SetLike[string] // The predeclared Go type identifiers.
 }
 
 // Constructors
 
 func (c *validatorClass_) Make() ValidatorLike {
+	return c.MakeWithRules()
+}
+
+func (c *validatorClass_) MakeWithRules(rules ...RuleType) ValidatorLike {
 	return &validator_{
+		WalkerLike:       Walker().Make(),
+		rules_:           col.Set[RuleType]().MakeFromArray(rules),
+		builtins_:        c.builtins_,
+		declarations_:    col.Catalog[string, DeclarationLike]().Make(),
+		typeParameters_:  col.Set[string]().Make(),
 		modules_:         col.Catalog[string, ModuleLike]().Make(),
 		specializations_: col.Catalog[string, SpecializationLike]().Make(),
 		functionals_:     col.Catalog[string, FunctionalLike]().Make(),
@@ -54,11 +88,40 @@ func (c *validatorClass_) Make() ValidatorLike {
 	}
 }
 
+// Functions
+
+func (c *validatorClass_) ReadRules(filename string) []RuleType {
+	var bytes, err = osx.ReadFile(filename)
+	if err != nil {
+		panic(err)
+	}
+	var rules []RuleType
+	var lines = sts.Split(string(bytes), "\n")
+	for _, line := range lines {
+		line = sts.TrimSpace(line)
+		if len(line) == 0 || sts.HasPrefix(line, "#") {
+			continue
+		}
+		rules = append(rules, Diagnostic().AsRule(line))
+	}
+	return rules
+}
+
 // INSTANCE METHODS
 
 // Target
 
 type validator_ struct {
+	// The validator only visits the abstractions when linting a model.
+	WalkerLike
+	rules_           col.SetLike[RuleType]
+	diagnostics_     col.ListLike[DiagnosticLike]
+	references_      col.SetLike[string] // The identifiers used by the model.
+	builtins_        col.SetLike[string]
+	declarations_    col.CatalogLike[string, DeclarationLike]
+	typeParameters_  col.SetLike[string] // The type parameters that are in scope.
+	declaration_     string              // The declaration being validated.
+	site_            string              // The declaration or member being validated.
 	modules_         col.CatalogLike[string, ModuleLike]
 	specializations_ col.CatalogLike[string, SpecializationLike]
 	functionals_     col.CatalogLike[string, FunctionalLike]
@@ -68,8 +131,42 @@ type validator_ struct {
 	abstractions_    col.CatalogLike[string, AbstractionLike]
 }
 
+// Visitor
+
+func (v *validator_) EnterAbstraction(abstraction AbstractionLike) {
+	var prefix = abstraction.GetPrefix()
+	if prefix != nil && prefix.GetType() == AliasPrefix {
+		// Module aliases are recorded with their trailing dot to distinguish
+		// them from local identifiers.
+		v.references_.AddValue(prefix.GetIdentifier() + ".")
+	}
+	v.references_.AddValue(abstraction.GetIdentifier())
+}
+
+func (v *validator_) EnterAttribute(attribute AttributeLike) {
+	v.addReferences(attribute.GetDefault())
+}
+
+func (v *validator_) EnterConstant(constant ConstantLike) {
+	v.addReferences(constant.GetValue())
+}
+
 // Public
 
+func (v *validator_) LintModel(model ModelLike) col.Sequential[DiagnosticLike] {
+	// Collect the identifiers that are referenced by the model.
+	v.diagnostics_ = col.List[DiagnosticLike]().Make()
+	v.references_ = col.Set[string]().Make()
+	v.Walk(model, v)
+
+	// Check the model against the enabled rules.
+	var comment = model.GetHeader().GetComment()
+	v.lintImports(model.GetImports(), comment)
+	v.lintTypes(model.GetTypes())
+	v.lintInterfaces(model.GetInterfaces())
+	return v.diagnostics_
+}
+
 func (v *validator_) ValidateModel(model ModelLike) {
 	// Extract the catalogs.
 	v.extractImports(model)
@@ -88,6 +185,72 @@ func (v *validator_) ValidateModel(model ModelLike) {
 
 // Private
 
+func (v *validator_) addReferences(annotation string) {
+	// An annotation is Go source code so any string literals are removed before
+	// its identifiers are recorded, and the selected members are skipped.
+	var literals = reg.MustCompile("`[^`]*`|\"(?:[^\"\\\\]|\\\\.)*\"|'(?:[^'\\\\]|\\\\.)*'")
+	annotation = literals.ReplaceAllString(annotation, " ")
+	var identifiers = reg.MustCompile(`\.?[\p{L}_][\p{L}\p{Nd}_]*\.?`)
+	for _, identifier := range identifiers.FindAllString(annotation, -1) {
+		if sts.HasPrefix(identifier, ".") {
+			continue
+		}
+		if sts.HasSuffix(identifier, ".") {
+			// Module aliases are recorded with their trailing dot.
+			v.references_.AddValue(identifier)
+			identifier = sts.TrimSuffix(identifier, ".")
+		}
+		v.references_.AddValue(identifier)
+	}
+}
+
+func (v *validator_) diagnose(
+	rule RuleType,
+	path string,
+	description string,
+	comments ...string,
+) {
+	if !v.rules_.ContainsValue(rule) || v.isSuppressed(rule, comments...) {
+		return
+	}
+	var diagnostic = Diagnostic().MakeWithAttributes(rule, path, description)
+	v.diagnostics_.AppendValue(diagnostic)
+}
+
+func (v *validator_) extractAttributeMethods(instance InstanceLike) col.Sequential[MethodLike] {
+	// Each attribute is a getter or setter method.
+	var methods = col.List[MethodLike]().Make()
+	var attributes = instance.GetAttributes()
+	if attributes == nil {
+		return methods
+	}
+	var iterator = attributes.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var attribute = iterator.GetNext()
+		var parameters ParametersLike
+		var parameter = attribute.GetParameter()
+		if parameter != nil {
+			var sequence = col.List[ParameterLike]().MakeFromArray(
+				[]ParameterLike{parameter},
+			)
+			parameters = Parameters().MakeWithAttributes(sequence)
+		}
+		var result ResultLike
+		var abstraction = attribute.GetAbstraction()
+		if abstraction != nil {
+			result = Result().MakeWithAbstraction(abstraction)
+		}
+		var method = Method().MakeWithAttributes(
+			attribute.GetComment(),
+			attribute.GetIdentifier(),
+			parameters,
+			result,
+		)
+		methods.AppendValue(method)
+	}
+	return methods
+}
+
 func (v *validator_) extractAspects(interfaces InterfacesLike) {
 	var aspects = interfaces.GetAspects()
 	if aspects == nil {
@@ -96,7 +259,9 @@ func (v *validator_) extractAspects(interfaces InterfacesLike) {
 	var iterator = aspects.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var aspect = iterator.GetNext()
-		var identifier = sts.ToLower(aspect.GetDeclaration().GetIdentifier())
+		var declaration = aspect.GetDeclaration()
+		v.declarations_.SetValue(declaration.GetIdentifier(), declaration)
+		var identifier = sts.ToLower(declaration.GetIdentifier())
 		v.aspects_.SetValue(identifier, aspect)
 	}
 }
@@ -109,13 +274,62 @@ func (v *validator_) extractClasses(interfaces InterfacesLike) {
 	var iterator = classes.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var class = iterator.GetNext()
-		var identifier = class.GetDeclaration().GetIdentifier()
-		identifier = sts.TrimSuffix(identifier, "ClassLike")
+		var declaration = class.GetDeclaration()
+		v.declarations_.SetValue(declaration.GetIdentifier(), declaration)
+		var identifier = sts.TrimSuffix(declaration.GetIdentifier(), "ClassLike")
 		identifier = sts.ToLower(identifier)
 		v.classes_.SetValue(identifier, class)
 	}
 }
 
+func (v *validator_) extractClassMethods(class ClassLike) col.Sequential[MethodLike] {
+	// Each class constant, constructor and function is treated as a method.
+	var methods = col.List[MethodLike]().Make()
+	var constants = class.GetConstants()
+	if constants != nil {
+		var iterator = constants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			var method = Method().MakeWithAttributes(
+				constant.GetComment(),
+				constant.GetIdentifier(),
+				nil,
+				Result().MakeWithAbstraction(constant.GetAbstraction()),
+			)
+			methods.AppendValue(method)
+		}
+	}
+	var constructors = class.GetConstructors()
+	if constructors != nil {
+		var iterator = constructors.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constructor = iterator.GetNext()
+			var method = Method().MakeWithAttributes(
+				constructor.GetComment(),
+				constructor.GetIdentifier(),
+				constructor.GetParameters(),
+				Result().MakeWithAbstraction(constructor.GetAbstraction()),
+			)
+			methods.AppendValue(method)
+		}
+	}
+	var functions = class.GetFunctions()
+	if functions != nil {
+		var iterator = functions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var function = iterator.GetNext()
+			var method = Method().MakeWithAttributes(
+				function.GetComment(),
+				function.GetIdentifier(),
+				function.GetParameters(),
+				function.GetResult(),
+			)
+			methods.AppendValue(method)
+		}
+	}
+	return methods
+}
+
 func (v *validator_) extractFunctionals(types TypesLike) {
 	var functionals = types.GetFunctionals()
 	if functionals == nil {
@@ -124,7 +338,9 @@ func (v *validator_) extractFunctionals(types TypesLike) {
 	var iterator = functionals.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var functional = iterator.GetNext()
-		var identifier = sts.ToLower(functional.GetDeclaration().GetIdentifier())
+		var declaration = functional.GetDeclaration()
+		v.declarations_.SetValue(declaration.GetIdentifier(), declaration)
+		var identifier = sts.ToLower(declaration.GetIdentifier())
 		v.functionals_.SetValue(identifier, functional)
 	}
 }
@@ -145,8 +361,9 @@ func (v *validator_) extractInstances(interfaces InterfacesLike) {
 	var iterator = instances.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var instance = iterator.GetNext()
-		var identifier = instance.GetDeclaration().GetIdentifier()
-		identifier = sts.TrimSuffix(identifier, "Like")
+		var declaration = instance.GetDeclaration()
+		v.declarations_.SetValue(declaration.GetIdentifier(), declaration)
+		var identifier = sts.TrimSuffix(declaration.GetIdentifier(), "Like")
 		identifier = sts.ToLower(identifier)
 		v.instances_.SetValue(identifier, instance)
 	}
@@ -163,6 +380,54 @@ func (v *validator_) extractInterfaces(model ModelLike) {
 	v.validateClasses()
 }
 
+func (v *validator_) extractMethods(abstraction AbstractionLike) col.Sequential[MethodLike] {
+	// Extract the methods that are inherited from an embedded local interface
+	// using the concrete types that were supplied for its generic types.
+	var methods = col.List[MethodLike]().Make()
+	var declaration DeclarationLike
+	var identifier = abstraction.GetIdentifier()
+	var aspect = v.retrieveAspect(identifier)
+	var class = v.retrieveClass(identifier)
+	var instance = v.retrieveInstance(identifier)
+	switch {
+	case aspect != nil:
+		declaration = aspect.GetDeclaration()
+		if aspect.GetMethods() != nil {
+			methods.AppendValues(aspect.GetMethods().GetSequence())
+		}
+	case class != nil:
+		declaration = class.GetDeclaration()
+		methods.AppendValues(v.extractClassMethods(class))
+	case instance != nil:
+		declaration = instance.GetDeclaration()
+		methods.AppendValues(v.extractAttributeMethods(instance))
+		var abstractions = instance.GetAbstractions()
+		if abstractions != nil {
+			var iterator = abstractions.GetSequence().GetIterator()
+			for iterator.HasNext() {
+				var embedded = iterator.GetNext()
+				if embedded.GetPrefix() == nil {
+					methods.AppendValues(v.extractMethods(embedded))
+				}
+			}
+		}
+		if instance.GetMethods() != nil {
+			methods.AppendValues(instance.GetMethods().GetSequence())
+		}
+	}
+	var substitutor = Substitutor().MakeWithTypes(
+		declaration.GetParameters(),
+		abstraction.GetArguments(),
+	)
+	var sequence = col.List[MethodLike]().Make()
+	var iterator = methods.GetIterator()
+	for iterator.HasNext() {
+		var method = substitutor.SubstituteMethod(iterator.GetNext())
+		sequence.AppendValue(method)
+	}
+	return sequence
+}
+
 func (v *validator_) extractModules(imports ImportsLike) {
 	var modules = imports.GetModules()
 	if modules == nil {
@@ -184,7 +449,9 @@ func (v *validator_) extractSpecializations(types TypesLike) {
 	var iterator = specializations.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var specialization = iterator.GetNext()
-		var identifier = sts.ToLower(specialization.GetDeclaration().GetIdentifier())
+		var declaration = specialization.GetDeclaration()
+		v.declarations_.SetValue(declaration.GetIdentifier(), declaration)
+		var identifier = sts.ToLower(declaration.GetIdentifier())
 		v.specializations_.SetValue(identifier, specialization)
 	}
 }
@@ -198,10 +465,356 @@ func (v *validator_) extractTypes(model ModelLike) {
 	v.extractFunctionals(types)
 }
 
+func (v *validator_) formatSignature(method MethodLike) string {
+	// Only the types matter when comparing method signatures.
+	var formatter = Formatter().Make()
+	var types []string
+	var parameters = method.GetParameters()
+	if parameters != nil {
+		var iterator = parameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var parameter = iterator.GetNext()
+			var type_ = formatter.FormatAbstraction(parameter.GetAbstraction())
+			if parameter.IsVariadic() {
+				type_ = "..." + type_
+			}
+			types = append(types, type_)
+		}
+	}
+	var signature = "(" + sts.Join(types, ", ") + ")"
+	var result = method.GetResult()
+	if result == nil {
+		return signature
+	}
+	var abstraction = result.GetAbstraction()
+	if abstraction != nil {
+		return signature + " " + formatter.FormatAbstraction(abstraction)
+	}
+	types = nil
+	var iterator = result.GetParameters().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		types = append(types, formatter.FormatAbstraction(parameter.GetAbstraction()))
+	}
+	return signature + " (" + sts.Join(types, ", ") + ")"
+}
+
+func (v *validator_) isInterface(identifier string) bool {
+	return v.retrieveAspect(identifier) != nil ||
+		v.retrieveClass(identifier) != nil ||
+		v.retrieveInstance(identifier) != nil
+}
+
+func (v *validator_) isSuppressed(rule RuleType, comments ...string) bool {
+	var name = Diagnostic().AsString(rule)
+	for _, comment := range comments {
+		var lines = sts.Split(comment, "\n")
+		for _, line := range lines {
+			line = sts.TrimSpace(line)
+			if !sts.HasPrefix(line, "nolint:") {
+				continue
+			}
+			var names = sts.Split(sts.TrimPrefix(line, "nolint:"), ",")
+			for _, suppressed := range names {
+				if sts.TrimSpace(suppressed) == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (v *validator_) lintAspect(aspect AspectLike) {
+	var declaration = aspect.GetDeclaration()
+	var identifier = declaration.GetIdentifier()
+	var comment = declaration.GetComment()
+	v.lintComment(identifier, identifier, comment, comment)
+	var methods = aspect.GetMethods()
+	if methods == nil {
+		return
+	}
+	var iterator = methods.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var method = iterator.GetNext()
+		var name = method.GetIdentifier()
+		var path = identifier + "." + name
+		v.lintComment(path, name, method.GetComment(), comment, method.GetComment())
+	}
+}
+
+func (v *validator_) lintClass(
+	class ClassLike,
+	parameters col.CatalogLike[string, ParametersLike],
+) {
+	var declaration = class.GetDeclaration()
+	var identifier = declaration.GetIdentifier()
+	var comment = declaration.GetComment()
+	v.lintComment(identifier, identifier, comment, comment)
+	var constants = class.GetConstants()
+	if constants != nil {
+		var iterator = constants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			var name = constant.GetIdentifier()
+			var path = identifier + "." + name
+			v.lintComment(path, name, constant.GetComment(), comment, constant.GetComment())
+		}
+	}
+	var constructors = class.GetConstructors()
+	if constructors != nil {
+		var iterator = constructors.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constructor = iterator.GetNext()
+			v.lintConstructor(identifier, comment, constructor)
+			if constructor.GetIdentifier() == "MakeWithAttributes" {
+				var name = sts.TrimSuffix(identifier, "ClassLike")
+				parameters.SetValue(name, constructor.GetParameters())
+			}
+		}
+	}
+	var functions = class.GetFunctions()
+	if functions != nil {
+		var iterator = functions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var function = iterator.GetNext()
+			var name = function.GetIdentifier()
+			var path = identifier + "." + name
+			v.lintComment(path, name, function.GetComment(), comment, function.GetComment())
+		}
+	}
+}
+
+func (v *validator_) lintComment(
+	path string,
+	identifier string,
+	comment string,
+	comments ...string,
+) {
+	if len(comment) == 0 {
+		return
+	}
+	var text = sts.TrimSpace(sts.TrimPrefix(comment, "/*"))
+	if !sts.HasPrefix(text, identifier) {
+		var description = fmt.Sprintf(
+			"The comment should begin with the identifier %v.",
+			identifier,
+		)
+		v.diagnose(CommentRule, path, description, comments...)
+	}
+}
+
+func (v *validator_) lintConstructor(
+	identifier string,
+	comment string,
+	constructor ConstructorLike,
+) {
+	var name = constructor.GetIdentifier()
+	var path = identifier + "." + name
+	v.lintComment(path, name, constructor.GetComment(), comment, constructor.GetComment())
+	switch {
+	case name == "Make":
+	case sts.HasPrefix(name, "MakeWith"):
+	case sts.HasPrefix(name, "MakeFrom"):
+	default:
+		var description = fmt.Sprintf(
+			"The constructor %v should be named Make, MakeWith* or MakeFrom*.",
+			name,
+		)
+		v.diagnose(ConstructorRule, path, description, comment, constructor.GetComment())
+	}
+}
+
+func (v *validator_) lintImports(imports ImportsLike, comment string) {
+	if imports == nil || imports.GetModules() == nil {
+		return
+	}
+	var iterator = imports.GetModules().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var alias = iterator.GetNext().GetIdentifier()
+		if !v.references_.ContainsValue(alias + ".") {
+			var description = fmt.Sprintf(
+				"The imported module %v is never used.",
+				alias,
+			)
+			v.diagnose(UsageRule, alias, description, comment)
+		}
+	}
+}
+
+func (v *validator_) lintInstance(
+	instance InstanceLike,
+	parameters col.CatalogLike[string, ParametersLike],
+) {
+	var declaration = instance.GetDeclaration()
+	var identifier = declaration.GetIdentifier()
+	var comment = declaration.GetComment()
+	v.lintComment(identifier, identifier, comment, comment)
+	var attributes = instance.GetAttributes()
+	if attributes != nil {
+		var constructor = parameters.GetValue(sts.TrimSuffix(identifier, "Like"))
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			var name = attribute.GetIdentifier()
+			var path = identifier + "." + name
+			v.lintComment(path, name, attribute.GetComment(), comment, attribute.GetComment())
+			if sts.HasPrefix(name, "Set") && constructor != nil {
+				v.lintSetter(path, attribute, constructor, comment, attribute.GetComment())
+			}
+		}
+	}
+	var methods = instance.GetMethods()
+	if methods != nil {
+		var iterator = methods.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var method = iterator.GetNext()
+			var name = method.GetIdentifier()
+			var path = identifier + "." + name
+			v.lintComment(path, name, method.GetComment(), comment, method.GetComment())
+		}
+	}
+}
+
+func (v *validator_) lintInterfaces(interfaces InterfacesLike) {
+	if interfaces == nil {
+		return
+	}
+	var aspects = interfaces.GetAspects()
+	if aspects != nil {
+		var iterator = aspects.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			v.lintAspect(iterator.GetNext())
+		}
+	}
+	// The class constructors must be linted before the instance setters.
+	var parameters = col.Catalog[string, ParametersLike]().Make()
+	var classes = interfaces.GetClasses()
+	if classes != nil {
+		var iterator = classes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			v.lintClass(iterator.GetNext(), parameters)
+		}
+	}
+	var instances = interfaces.GetInstances()
+	if instances != nil {
+		var iterator = instances.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			v.lintInstance(iterator.GetNext(), parameters)
+		}
+	}
+}
+
+func (v *validator_) lintSetter(
+	path string,
+	attribute AttributeLike,
+	parameters ParametersLike,
+	comments ...string,
+) {
+	var name = sts.TrimPrefix(attribute.GetIdentifier(), "Set")
+	var parameter = attribute.GetParameter().GetIdentifier()
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var expected = iterator.GetNext().GetIdentifier()
+		if !sts.EqualFold(sts.TrimSuffix(expected, "_"), name) {
+			continue
+		}
+		if parameter != expected {
+			var description = fmt.Sprintf(
+				"The parameter %v should be named %v to match the MakeWithAttributes constructor.",
+				parameter,
+				expected,
+			)
+			v.diagnose(ParameterRule, path, description, comments...)
+		}
+	}
+}
+
+func (v *validator_) lintTypes(types TypesLike) {
+	if types == nil {
+		return
+	}
+	var specializations = types.GetSpecializations()
+	if specializations != nil {
+		var iterator = specializations.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var specialization = iterator.GetNext()
+			var declaration = specialization.GetDeclaration()
+			var identifier = declaration.GetIdentifier()
+			var comment = declaration.GetComment()
+			v.lintComment(identifier, identifier, comment, comment)
+			var enumeration = specialization.GetEnumeration()
+			if enumeration == nil {
+				continue
+			}
+			var first = enumeration.GetValues().GetParameter().GetIdentifier()
+			if !sts.HasPrefix(first, "Error") {
+				var description = fmt.Sprintf(
+					"The first value of the enumeration should be named Error*: %v",
+					first,
+				)
+				v.diagnose(EnumerationRule, identifier+"."+first, description, comment)
+			}
+		}
+	}
+	var functionals = types.GetFunctionals()
+	if functionals != nil {
+		var iterator = functionals.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var declaration = iterator.GetNext().GetDeclaration()
+			var identifier = declaration.GetIdentifier()
+			var comment = declaration.GetComment()
+			v.lintComment(identifier, identifier, comment, comment)
+			if !v.references_.ContainsValue(identifier) {
+				var description = fmt.Sprintf(
+					"The functional %v is never used.",
+					identifier,
+				)
+				v.diagnose(UsageRule, identifier, description, comment)
+			}
+		}
+	}
+}
+
+func (v *validator_) retrieveAspect(identifier string) AspectLike {
+	var iterator = v.aspects_.GetIterator()
+	for iterator.HasNext() {
+		var aspect = iterator.GetNext().GetValue()
+		if aspect.GetDeclaration().GetIdentifier() == identifier {
+			return aspect
+		}
+	}
+	return nil
+}
+
+func (v *validator_) retrieveClass(identifier string) ClassLike {
+	var iterator = v.classes_.GetIterator()
+	for iterator.HasNext() {
+		var class = iterator.GetNext().GetValue()
+		if class.GetDeclaration().GetIdentifier() == identifier {
+			return class
+		}
+	}
+	return nil
+}
+
+func (v *validator_) retrieveInstance(identifier string) InstanceLike {
+	var iterator = v.instances_.GetIterator()
+	for iterator.HasNext() {
+		var instance = iterator.GetNext().GetValue()
+		if instance.GetDeclaration().GetIdentifier() == identifier {
+			return instance
+		}
+	}
+	return nil
+}
+
 func (v *validator_) validateAbstraction(abstraction AbstractionLike) {
+	var isLocal = true
 	var prefix = abstraction.GetPrefix()
 	if prefix != nil {
 		v.validatePrefix(prefix)
+		isLocal = prefix.GetType() != AliasPrefix
 	}
 	var identifier = abstraction.GetIdentifier()
 	v.abstractions_.SetValue(identifier, abstraction)
@@ -209,6 +822,10 @@ func (v *validator_) validateAbstraction(abstraction AbstractionLike) {
 	if arguments != nil {
 		v.validateArguments(arguments)
 	}
+	if isLocal {
+		// Identifiers from imported modules cannot be resolved here.
+		v.validateReference(identifier, arguments)
+	}
 }
 
 func (v *validator_) validateAbstractions(abstractions AbstractionsLike) {
@@ -257,12 +874,14 @@ func (v *validator_) validateAspects() {
 
 func (v *validator_) validateAttribute(attribute AttributeLike) {
 	var identifier = attribute.GetIdentifier()
+	v.site_ = v.declaration_ + "." + identifier
 	var parameter = attribute.GetParameter()
 	var abstraction = attribute.GetAbstraction()
 	switch {
 	case sts.HasPrefix(identifier, "Get"):
 		v.validateAbstraction(abstraction)
 	case sts.HasPrefix(identifier, "Set"):
+		v.validateNonvariadic(parameter)
 		v.validateParameter(parameter)
 	case sts.HasPrefix(identifier, "Is"):
 		v.validateBoolean(abstraction)
@@ -336,6 +955,7 @@ func (v *validator_) validateClasses() {
 }
 
 func (v *validator_) validateConstant(constant ConstantLike) {
+	v.site_ = v.declaration_ + "." + constant.GetIdentifier()
 	var abstraction = constant.GetAbstraction()
 	v.validateAbstraction(abstraction)
 }
@@ -349,6 +969,7 @@ func (v *validator_) validateConstants(constants ConstantsLike) {
 }
 
 func (v *validator_) validateConstructor(constructor ConstructorLike) {
+	v.site_ = v.declaration_ + "." + constructor.GetIdentifier()
 	var parameters = constructor.GetParameters()
 	if parameters != nil {
 		v.validateParameters(parameters)
@@ -366,18 +987,64 @@ func (v *validator_) validateConstructors(constructors ConstructorsLike) {
 }
 
 func (v *validator_) validateDeclaration(declaration DeclarationLike) {
+	// Bring the type parameters of the declaration into scope.
+	v.declaration_ = declaration.GetIdentifier()
+	v.site_ = v.declaration_
+	v.typeParameters_ = col.Set[string]().Make()
 	var parameters = declaration.GetParameters()
 	if parameters != nil {
+		var iterator = parameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			v.typeParameters_.AddValue(iterator.GetNext().GetIdentifier())
+		}
+		v.validateNonvariadics(parameters)
 		v.validateParameters(parameters)
 	}
 }
 
+func (v *validator_) validateEmbeddings(identifier string, embedders []string) {
+	// The embedders are the chain of instance interfaces that lead to this one.
+	var chain = append(embedders, identifier)
+	for _, embedder := range embedders {
+		if embedder == identifier {
+			var message = fmt.Sprintf(
+				"An instance interface cannot embed itself: %v",
+				sts.Join(chain, " -> "),
+			)
+			panic(message)
+		}
+	}
+	var instance = v.retrieveInstance(identifier)
+	if instance == nil || instance.GetAbstractions() == nil {
+		return
+	}
+	var iterator = instance.GetAbstractions().GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var abstraction = iterator.GetNext()
+		if abstraction.GetPrefix() != nil {
+			// The abstractions from imported modules cannot be checked here.
+			continue
+		}
+		var embedded = abstraction.GetIdentifier()
+		if !v.isInterface(embedded) {
+			var message = fmt.Sprintf(
+				"Only aspects, class interfaces and instance interfaces may be embedded in %v: %v",
+				identifier,
+				embedded,
+			)
+			panic(message)
+		}
+		v.validateEmbeddings(embedded, chain)
+	}
+}
+
 func (v *validator_) validateEnumeration(enumeration EnumerationLike) {
 	var values = enumeration.GetValues()
 	v.validateValues(values)
 }
 
 func (v *validator_) validateFunction(function FunctionLike) {
+	v.site_ = v.declaration_ + "." + function.GetIdentifier()
 	var parameters = function.GetParameters()
 	if parameters != nil {
 		v.validateParameters(parameters)
@@ -434,7 +1101,9 @@ func (v *validator_) validateInstance(instance InstanceLike) {
 	var abstractions = instance.GetAbstractions()
 	if abstractions != nil {
 		v.validateAbstractions(abstractions)
+		v.validateEmbeddings(declaration.GetIdentifier(), nil)
 	}
+	v.validateMethodSet(instance)
 	var methods = instance.GetMethods()
 	if methods != nil {
 		v.validateMethods(methods)
@@ -452,6 +1121,7 @@ func (v *validator_) validateInstances() {
 }
 
 func (v *validator_) validateMethod(method MethodLike) {
+	v.site_ = v.declaration_ + "." + method.GetIdentifier()
 	var parameters = method.GetParameters()
 	if parameters != nil {
 		v.validateParameters(parameters)
@@ -462,6 +1132,44 @@ func (v *validator_) validateMethod(method MethodLike) {
 	}
 }
 
+func (v *validator_) validateMethodSet(instance InstanceLike) {
+	// Each method in the effective method set of an instance interface must be
+	// supplied exactly once, otherwise the generated class would not compile.
+	var identifier = instance.GetDeclaration().GetIdentifier()
+	var methods = col.Catalog[string, MethodLike]().Make()
+	var sources = col.Catalog[string, string]().Make()
+	var attributes = v.extractAttributeMethods(instance).GetIterator()
+	for attributes.HasNext() {
+		var method = attributes.GetNext()
+		v.validateUniqueMethod(identifier, "its attributes", method, methods, sources)
+	}
+	var abstractions = instance.GetAbstractions()
+	if abstractions != nil {
+		var formatter = Formatter().Make()
+		var iterator = abstractions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var abstraction = iterator.GetNext()
+			if abstraction.GetPrefix() != nil {
+				// We only know the method signatures for the local abstractions.
+				continue
+			}
+			var source = formatter.FormatAbstraction(abstraction)
+			var inherited = v.extractMethods(abstraction).GetIterator()
+			for inherited.HasNext() {
+				var method = inherited.GetNext()
+				v.validateUniqueMethod(identifier, source, method, methods, sources)
+			}
+		}
+	}
+	if instance.GetMethods() != nil {
+		var iterator = instance.GetMethods().GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var method = iterator.GetNext()
+			v.validateUniqueMethod(identifier, "its methods", method, methods, sources)
+		}
+	}
+}
+
 func (v *validator_) validateMethods(methods MethodsLike) {
 	var iterator = methods.GetSequence().GetIterator()
 	for iterator.HasNext() {
@@ -491,27 +1199,17 @@ func (v *validator_) validateModules() {
 }
 
 func (v *validator_) validatePairings() {
-	// Make sure each class interface has an associated instance interface.
-	var classes = col.List[string]().MakeFromSequence(v.classes_.GetKeys())
-	var instances = col.List[string]().MakeFromSequence(v.instances_.GetKeys())
-	if classes.GetSize() != instances.GetSize() {
-		var message = fmt.Sprintf(
-			"Mismatched class and instance interfaces:\n%v\n%v\n",
-			classes,
-			instances,
-		)
-		panic(message)
-	}
-	var classIterator = classes.GetIterator()
-	var instanceIterator = instances.GetIterator()
-	for classIterator.HasNext() {
-		var class = classIterator.GetNext()
-		var instance = instanceIterator.GetNext()
-		if class != instance {
+	// Class and instance interfaces are paired by name but either may stand
+	// alone.  A standalone class interface has no instances to construct.
+	var iterator = v.classes_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var class = association.GetValue()
+		var instance = v.instances_.GetValue(association.GetKey())
+		if instance == nil && class.GetConstructors() != nil {
 			var message = fmt.Sprintf(
-				"Mismatched class and instance interfaces:\n%v\n%v\n",
-				class,
-				instance,
+				"A standalone class interface cannot declare constructors: %v",
+				class.GetDeclaration().GetIdentifier(),
 			)
 			panic(message)
 		}
@@ -527,12 +1225,44 @@ func (v *validator_) validateParameters(parameters ParametersLike) {
 	var iterator = parameters.GetSequence().GetIterator()
 	for iterator.HasNext() {
 		var parameter = iterator.GetNext()
+		if parameter.IsVariadic() && iterator.HasNext() {
+			var message = fmt.Sprintf(
+				"Only the last parameter may be variadic: %v",
+				parameter.GetIdentifier(),
+			)
+			panic(message)
+		}
 		v.validateParameter(parameter)
 	}
 }
 
+func (v *validator_) validateNonvariadic(parameter ParameterLike) {
+	if parameter.IsVariadic() {
+		var message = fmt.Sprintf(
+			"A variadic parameter is not allowed here: %v",
+			parameter.GetIdentifier(),
+		)
+		panic(message)
+	}
+}
+
+func (v *validator_) validateNonvariadics(parameters ParametersLike) {
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		v.validateNonvariadic(parameter)
+	}
+}
+
 func (v *validator_) validatePrefix(prefix PrefixLike) {
-	if prefix == nil || prefix.GetType() != AliasPrefix {
+	if prefix == nil {
+		return
+	}
+	if prefix.GetType() == MapPrefix {
+		v.validateReference(prefix.GetIdentifier(), nil)
+		return
+	}
+	if prefix.GetType() != AliasPrefix {
 		return
 	}
 	var identifier = prefix.GetIdentifier()
@@ -545,12 +1275,66 @@ func (v *validator_) validatePrefix(prefix PrefixLike) {
 	}
 }
 
+func (v *validator_) validateReference(identifier string, arguments ArgumentsLike) {
+	var supplied int
+	if arguments != nil {
+		supplied = arguments.GetSequence().GetSize()
+	}
+	if v.builtins_.ContainsValue(identifier) || v.typeParameters_.ContainsValue(identifier) {
+		if supplied > 0 {
+			var message = fmt.Sprintf(
+				"The type %v used in %v is not generic but was given %v type arguments.",
+				identifier,
+				v.site_,
+				supplied,
+			)
+			panic(message)
+		}
+		return
+	}
+	var declaration = v.declarations_.GetValue(identifier)
+	if declaration == nil {
+		var message = fmt.Sprintf(
+			"The type %v used in %v is not a builtin, type parameter, specialization, functional, aspect, class or instance.",
+			identifier,
+			v.site_,
+		)
+		panic(message)
+	}
+	var required int
+	var names []string
+	var parameters = declaration.GetParameters()
+	if parameters != nil {
+		required = parameters.GetSequence().GetSize()
+		var iterator = parameters.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			names = append(names, iterator.GetNext().GetIdentifier())
+		}
+	}
+	if supplied != required {
+		var signature = identifier
+		if required > 0 {
+			signature += "[" + sts.Join(names, ", ") + "]"
+		}
+		var message = fmt.Sprintf(
+			"The type %v used in %v was given %v type arguments but its declaration %v requires %v.",
+			identifier,
+			v.site_,
+			supplied,
+			signature,
+			required,
+		)
+		panic(message)
+	}
+}
+
 func (v *validator_) validateResult(result ResultLike) {
 	var abstraction = result.GetAbstraction()
 	if abstraction != nil {
 		v.validateAbstraction(abstraction)
 	} else {
 		var parameters = result.GetParameters()
+		v.validateNonvariadics(parameters)
 		v.validateParameters(parameters)
 	}
 }
@@ -585,7 +1369,48 @@ func (v *validator_) validateSpecializations() {
 	}
 }
 
+func (v *validator_) validateUniqueMethod(
+	identifier string,
+	source string,
+	method MethodLike,
+	methods col.CatalogLike[string, MethodLike],
+	sources col.CatalogLike[string, string],
+) {
+	var name = method.GetIdentifier()
+	var existing = methods.GetValue(name)
+	if existing == nil {
+		methods.SetValue(name, method)
+		sources.SetValue(name, source)
+		return
+	}
+	var signature = v.formatSignature(method)
+	var existingSignature = v.formatSignature(existing)
+	if signature == existingSignature {
+		var message = fmt.Sprintf(
+			"The method %v of %v is supplied by both %v and %v.",
+			name,
+			identifier,
+			sources.GetValue(name),
+			source,
+		)
+		panic(message)
+	}
+	var message = fmt.Sprintf(
+		"The method %v of %v has conflicting signatures: %v%v from %v and %v%v from %v.",
+		name,
+		identifier,
+		name,
+		existingSignature,
+		sources.GetValue(name),
+		name,
+		signature,
+		source,
+	)
+	panic(message)
+}
+
 func (v *validator_) validateValues(values ValuesLike) {
 	var parameter = values.GetParameter()
+	v.validateNonvariadic(parameter)
 	v.validateParameter(parameter)
 }
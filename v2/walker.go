@@ -0,0 +1,470 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See http://opensource.org/licenses/MIT)                        .
+................................................................................
+*/
+
+package packages
+
+// CLASS ACCESS
+
+// Reference
+
+var walkerClass = &walkerClass_{
+	// This class does not initialize any class constants.
+}
+
+// Function
+
+func Walker() WalkerClassLike {
+	return walkerClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type walkerClass_ struct {
+	// This class does not define any class constants.
+}
+
+// Constructors
+
+func (c *walkerClass_) Make() WalkerLike {
+	return &walker_{
+		// This class does not initialize any instance attributes.
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type walker_ struct {
+	visitor_ Visitor // Only set while walking a model.
+}
+
+// Visitor
+
+func (v *walker_) EnterAbstraction(abstraction AbstractionLike) {
+}
+
+func (v *walker_) EnterAspect(aspect AspectLike) {
+}
+
+func (v *walker_) EnterAttribute(attribute AttributeLike) {
+}
+
+func (v *walker_) EnterClass(class ClassLike) {
+}
+
+func (v *walker_) EnterConstant(constant ConstantLike) {
+}
+
+func (v *walker_) EnterConstructor(constructor ConstructorLike) {
+}
+
+func (v *walker_) EnterDeclaration(declaration DeclarationLike) {
+}
+
+func (v *walker_) EnterEnumeration(enumeration EnumerationLike) {
+}
+
+func (v *walker_) EnterFunction(function FunctionLike) {
+}
+
+func (v *walker_) EnterFunctional(functional FunctionalLike) {
+}
+
+func (v *walker_) EnterHeader(header HeaderLike) {
+}
+
+func (v *walker_) EnterImports(imports ImportsLike) {
+}
+
+func (v *walker_) EnterInstance(instance InstanceLike) {
+}
+
+func (v *walker_) EnterInterfaces(interfaces InterfacesLike) {
+}
+
+func (v *walker_) EnterMethod(method MethodLike) {
+}
+
+func (v *walker_) EnterModel(model ModelLike) {
+}
+
+func (v *walker_) EnterModule(module ModuleLike) {
+}
+
+func (v *walker_) EnterNotice(notice NoticeLike) {
+}
+
+func (v *walker_) EnterParameter(parameter ParameterLike) {
+}
+
+func (v *walker_) EnterResult(result ResultLike) {
+}
+
+func (v *walker_) EnterSpecialization(specialization SpecializationLike) {
+}
+
+func (v *walker_) EnterTypes(types TypesLike) {
+}
+
+func (v *walker_) LeaveAbstraction(abstraction AbstractionLike) {
+}
+
+func (v *walker_) LeaveAspect(aspect AspectLike) {
+}
+
+func (v *walker_) LeaveAttribute(attribute AttributeLike) {
+}
+
+func (v *walker_) LeaveClass(class ClassLike) {
+}
+
+func (v *walker_) LeaveConstant(constant ConstantLike) {
+}
+
+func (v *walker_) LeaveConstructor(constructor ConstructorLike) {
+}
+
+func (v *walker_) LeaveDeclaration(declaration DeclarationLike) {
+}
+
+func (v *walker_) LeaveEnumeration(enumeration EnumerationLike) {
+}
+
+func (v *walker_) LeaveFunction(function FunctionLike) {
+}
+
+func (v *walker_) LeaveFunctional(functional FunctionalLike) {
+}
+
+func (v *walker_) LeaveHeader(header HeaderLike) {
+}
+
+func (v *walker_) LeaveImports(imports ImportsLike) {
+}
+
+func (v *walker_) LeaveInstance(instance InstanceLike) {
+}
+
+func (v *walker_) LeaveInterfaces(interfaces InterfacesLike) {
+}
+
+func (v *walker_) LeaveMethod(method MethodLike) {
+}
+
+func (v *walker_) LeaveModel(model ModelLike) {
+}
+
+func (v *walker_) LeaveModule(module ModuleLike) {
+}
+
+func (v *walker_) LeaveNotice(notice NoticeLike) {
+}
+
+func (v *walker_) LeaveParameter(parameter ParameterLike) {
+}
+
+func (v *walker_) LeaveResult(result ResultLike) {
+}
+
+func (v *walker_) LeaveSpecialization(specialization SpecializationLike) {
+}
+
+func (v *walker_) LeaveTypes(types TypesLike) {
+}
+
+// Public
+
+func (v *walker_) Walk(model ModelLike, visitor Visitor) {
+	// A separate walker is used so that this walker may walk concurrently.
+	var walker = &walker_{
+		visitor_: visitor,
+	}
+	walker.walkModel(model)
+}
+
+// Private
+
+func (v *walker_) walkAbstraction(abstraction AbstractionLike) {
+	v.visitor_.EnterAbstraction(abstraction)
+	var arguments = abstraction.GetArguments()
+	if arguments != nil {
+		var iterator = arguments.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var argument = iterator.GetNext()
+			v.walkAbstraction(argument)
+		}
+	}
+	v.visitor_.LeaveAbstraction(abstraction)
+}
+
+func (v *walker_) walkAspect(aspect AspectLike) {
+	v.visitor_.EnterAspect(aspect)
+	v.walkDeclaration(aspect.GetDeclaration())
+	v.walkMethods(aspect.GetMethods())
+	v.visitor_.LeaveAspect(aspect)
+}
+
+func (v *walker_) walkAttribute(attribute AttributeLike) {
+	v.visitor_.EnterAttribute(attribute)
+	var parameter = attribute.GetParameter()
+	if parameter != nil {
+		v.walkParameter(parameter)
+	}
+	var abstraction = attribute.GetAbstraction()
+	if abstraction != nil {
+		v.walkAbstraction(abstraction)
+	}
+	v.visitor_.LeaveAttribute(attribute)
+}
+
+func (v *walker_) walkClass(class ClassLike) {
+	v.visitor_.EnterClass(class)
+	v.walkDeclaration(class.GetDeclaration())
+	var constants = class.GetConstants()
+	if constants != nil {
+		var iterator = constants.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constant = iterator.GetNext()
+			v.walkConstant(constant)
+		}
+	}
+	var constructors = class.GetConstructors()
+	if constructors != nil {
+		var iterator = constructors.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var constructor = iterator.GetNext()
+			v.walkConstructor(constructor)
+		}
+	}
+	var functions = class.GetFunctions()
+	if functions != nil {
+		var iterator = functions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var function = iterator.GetNext()
+			v.walkFunction(function)
+		}
+	}
+	v.visitor_.LeaveClass(class)
+}
+
+func (v *walker_) walkConstant(constant ConstantLike) {
+	v.visitor_.EnterConstant(constant)
+	v.walkAbstraction(constant.GetAbstraction())
+	v.visitor_.LeaveConstant(constant)
+}
+
+func (v *walker_) walkConstructor(constructor ConstructorLike) {
+	v.visitor_.EnterConstructor(constructor)
+	v.walkParameters(constructor.GetParameters())
+	v.walkAbstraction(constructor.GetAbstraction())
+	v.visitor_.LeaveConstructor(constructor)
+}
+
+func (v *walker_) walkDeclaration(declaration DeclarationLike) {
+	v.visitor_.EnterDeclaration(declaration)
+	v.walkParameters(declaration.GetParameters())
+	v.visitor_.LeaveDeclaration(declaration)
+}
+
+func (v *walker_) walkEnumeration(enumeration EnumerationLike) {
+	v.visitor_.EnterEnumeration(enumeration)
+	v.walkParameter(enumeration.GetValues().GetParameter())
+	v.visitor_.LeaveEnumeration(enumeration)
+}
+
+func (v *walker_) walkFunction(function FunctionLike) {
+	v.visitor_.EnterFunction(function)
+	v.walkParameters(function.GetParameters())
+	v.walkResult(function.GetResult())
+	v.visitor_.LeaveFunction(function)
+}
+
+func (v *walker_) walkFunctional(functional FunctionalLike) {
+	v.visitor_.EnterFunctional(functional)
+	v.walkDeclaration(functional.GetDeclaration())
+	v.walkParameters(functional.GetParameters())
+	v.walkResult(functional.GetResult())
+	v.visitor_.LeaveFunctional(functional)
+}
+
+func (v *walker_) walkImports(imports ImportsLike) {
+	v.visitor_.EnterImports(imports)
+	var modules = imports.GetModules()
+	if modules != nil {
+		var iterator = modules.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var module = iterator.GetNext()
+			v.visitor_.EnterModule(module)
+			v.visitor_.LeaveModule(module)
+		}
+	}
+	v.visitor_.LeaveImports(imports)
+}
+
+func (v *walker_) walkInstance(instance InstanceLike) {
+	v.visitor_.EnterInstance(instance)
+	v.walkDeclaration(instance.GetDeclaration())
+	var attributes = instance.GetAttributes()
+	if attributes != nil {
+		var iterator = attributes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var attribute = iterator.GetNext()
+			v.walkAttribute(attribute)
+		}
+	}
+	var abstractions = instance.GetAbstractions()
+	if abstractions != nil {
+		var iterator = abstractions.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var abstraction = iterator.GetNext()
+			v.walkAbstraction(abstraction)
+		}
+	}
+	v.walkMethods(instance.GetMethods())
+	v.visitor_.LeaveInstance(instance)
+}
+
+func (v *walker_) walkInterfaces(interfaces InterfacesLike) {
+	v.visitor_.EnterInterfaces(interfaces)
+	var aspects = interfaces.GetAspects()
+	if aspects != nil {
+		var iterator = aspects.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var aspect = iterator.GetNext()
+			v.walkAspect(aspect)
+		}
+	}
+	var classes = interfaces.GetClasses()
+	if classes != nil {
+		var iterator = classes.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var class = iterator.GetNext()
+			v.walkClass(class)
+		}
+	}
+	var instances = interfaces.GetInstances()
+	if instances != nil {
+		var iterator = instances.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var instance = iterator.GetNext()
+			v.walkInstance(instance)
+		}
+	}
+	v.visitor_.LeaveInterfaces(interfaces)
+}
+
+func (v *walker_) walkMethod(method MethodLike) {
+	v.visitor_.EnterMethod(method)
+	v.walkParameters(method.GetParameters())
+	v.walkResult(method.GetResult())
+	v.visitor_.LeaveMethod(method)
+}
+
+func (v *walker_) walkMethods(methods MethodsLike) {
+	if methods == nil {
+		return
+	}
+	var iterator = methods.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var method = iterator.GetNext()
+		v.walkMethod(method)
+	}
+}
+
+func (v *walker_) walkModel(model ModelLike) {
+	v.visitor_.EnterModel(model)
+	var notice = model.GetNotice()
+	v.visitor_.EnterNotice(notice)
+	v.visitor_.LeaveNotice(notice)
+	var header = model.GetHeader()
+	v.visitor_.EnterHeader(header)
+	v.visitor_.LeaveHeader(header)
+	var imports = model.GetImports()
+	if imports != nil {
+		v.walkImports(imports)
+	}
+	var types = model.GetTypes()
+	if types != nil {
+		v.walkTypes(types)
+	}
+	var interfaces = model.GetInterfaces()
+	if interfaces != nil {
+		v.walkInterfaces(interfaces)
+	}
+	v.visitor_.LeaveModel(model)
+}
+
+func (v *walker_) walkParameter(parameter ParameterLike) {
+	v.visitor_.EnterParameter(parameter)
+	v.walkAbstraction(parameter.GetAbstraction())
+	v.visitor_.LeaveParameter(parameter)
+}
+
+func (v *walker_) walkParameters(parameters ParametersLike) {
+	if parameters == nil {
+		return
+	}
+	var iterator = parameters.GetSequence().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		v.walkParameter(parameter)
+	}
+}
+
+func (v *walker_) walkResult(result ResultLike) {
+	if result == nil {
+		return
+	}
+	v.visitor_.EnterResult(result)
+	var abstraction = result.GetAbstraction()
+	if abstraction != nil {
+		v.walkAbstraction(abstraction)
+	} else {
+		v.walkParameters(result.GetParameters())
+	}
+	v.visitor_.LeaveResult(result)
+}
+
+func (v *walker_) walkSpecialization(specialization SpecializationLike) {
+	v.visitor_.EnterSpecialization(specialization)
+	v.walkDeclaration(specialization.GetDeclaration())
+	v.walkAbstraction(specialization.GetAbstraction())
+	var enumeration = specialization.GetEnumeration()
+	if enumeration != nil {
+		v.walkEnumeration(enumeration)
+	}
+	v.visitor_.LeaveSpecialization(specialization)
+}
+
+func (v *walker_) walkTypes(types TypesLike) {
+	v.visitor_.EnterTypes(types)
+	var specializations = types.GetSpecializations()
+	if specializations != nil {
+		var iterator = specializations.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var specialization = iterator.GetNext()
+			v.walkSpecialization(specialization)
+		}
+	}
+	var functionals = types.GetFunctionals()
+	if functionals != nil {
+		var iterator = functionals.GetSequence().GetIterator()
+		for iterator.HasNext() {
+			var functional = iterator.GetNext()
+			v.walkFunctional(functional)
+		}
+	}
+	v.visitor_.LeaveTypes(types)
+}